@@ -0,0 +1,125 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Aggregator struct {
+	Name   string
+	key    any
+	reduce func(values []any) any
+}
+
+func (a Aggregator) As(name string) Aggregator {
+	a.Name = name
+	return a
+}
+
+func aggregate[I any](aggregator Aggregator, items []I) any {
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		if aggregator.key == nil {
+			values = append(values, item)
+		} else if v, err := AnyGet[any](item, aggregator.key); err == nil {
+			values = append(values, v)
+		}
+	}
+
+	return aggregator.reduce(values)
+}
+
+func aggregatorName(name string, key any) string {
+	if key == nil {
+		return name
+	}
+	return fmt.Sprintf("%s(%v)", name, key)
+}
+
+func sumValues(values []any) (float64, int) {
+	var s compensatedSum
+	var count int
+	for _, value := range values {
+		if value == nil {
+			continue
+		} else if f, ok := toFloat(reflect.ValueOf(value)); ok {
+			s.Add(f)
+			count++
+		}
+	}
+	return s.Result(), count
+}
+
+func pickValue(values []any, better func(c int) bool) any {
+	var picked any
+	for _, value := range values {
+		if picked == nil {
+			picked = value
+		} else if c, ok := compareOrder(value, picked); ok && better(c) {
+			picked = value
+		}
+	}
+	return picked
+}
+
+func AggCount() Aggregator {
+	return Aggregator{"count", nil, func(values []any) any {
+		return len(values)
+	}}
+}
+
+func AggSum(key any) Aggregator {
+	return Aggregator{aggregatorName("sum", key), key, func(values []any) any {
+		sum, _ := sumValues(values)
+		return sum
+	}}
+}
+
+func AggAvg(key any) Aggregator {
+	return Aggregator{aggregatorName("avg", key), key, func(values []any) any {
+		if sum, count := sumValues(values); count > 0 {
+			return sum / float64(count)
+		}
+		return float64(0)
+	}}
+}
+
+func AggMin(key any) Aggregator {
+	return Aggregator{aggregatorName("min", key), key, func(values []any) any {
+		return pickValue(values, func(c int) bool { return c < 0 })
+	}}
+}
+
+func AggMax(key any) Aggregator {
+	return Aggregator{aggregatorName("max", key), key, func(values []any) any {
+		return pickValue(values, func(c int) bool { return c > 0 })
+	}}
+}
+
+func AggFirst(key any) Aggregator {
+	return Aggregator{aggregatorName("first", key), key, func(values []any) any {
+		if len(values) == 0 {
+			return nil
+		}
+		return values[0]
+	}}
+}
+
+func AggLast(key any) Aggregator {
+	return Aggregator{aggregatorName("last", key), key, func(values []any) any {
+		if len(values) == 0 {
+			return nil
+		}
+		return values[len(values)-1]
+	}}
+}
+
+func AggFunc[I any](name string, callback func(items []I) any) Aggregator {
+	return Aggregator{name, nil, func(values []any) any {
+		items := make([]I, len(values))
+		for i, value := range values {
+			items[i] = value.(I)
+		}
+		return callback(items)
+	}}
+}
@@ -0,0 +1,157 @@
+package collect
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+func FromChannel[E any](ch <-chan E) *Stream[E] {
+	s := NewStream[E](nil)
+	s.next = func() (E, error) {
+		var zero E
+		select {
+		case <-s.ctx.Done():
+			return zero, s.ctx.Err()
+		case value, ok := <-ch:
+			if !ok {
+				return zero, io.EOF
+			}
+			return value, nil
+		}
+	}
+	return s
+}
+
+func UseChan[E any](ch <-chan E) *SliceCollection[[]E, E] {
+	return FromChannel(ch).Collect()
+}
+
+// ToChannel sends the items to the returned channel, which is closed once all of them are received or the context is done.
+func ToChannel[T ~[]E, E any](ctx context.Context, items T, buffer int) <-chan E {
+	ch := make(chan E, buffer)
+	go func() {
+		defer close(ch)
+		for _, item := range items {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- item:
+			}
+		}
+	}()
+	return ch
+}
+
+// forward sends the values of ch to out until ch is closed or the context is done,
+// it also stops while waiting on an input that stays open.
+func forward[E any](ctx context.Context, ch <-chan E, out chan<- E) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case value, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- value:
+			}
+		}
+	}
+}
+
+func FanIn[E any](ctx context.Context, channels ...<-chan E) <-chan E {
+	out := make(chan E)
+
+	var wg sync.WaitGroup
+	for _, ch := range channels {
+		wg.Add(1)
+		go func(ch <-chan E) {
+			defer wg.Done()
+			forward(ctx, ch, out)
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values of the channel over n channels, each value is received by whichever is ready first.
+func FanOut[E any](ctx context.Context, ch <-chan E, n int) []<-chan E {
+	if n < 1 {
+		panic("number of channels must be positive")
+	}
+
+	channels := make([]<-chan E, n)
+	for i := range channels {
+		out := make(chan E)
+		channels[i] = out
+
+		go func() {
+			defer close(out)
+			forward(ctx, ch, out)
+		}()
+	}
+	return channels
+}
+
+// Batch groups the values of the channel into slices of the given size, a batch that is not full
+// is emitted when the timeout elapses after its first value, or when the channel is closed.
+func Batch[E any](ctx context.Context, ch <-chan E, size int, timeout time.Duration) <-chan []E {
+	if size < 1 {
+		panic("batch size must be positive")
+	}
+
+	out := make(chan []E)
+	go func() {
+		defer close(out)
+
+		var batch []E
+		var timer <-chan time.Time
+		flush := func() bool {
+			if len(batch) == 0 {
+				return true
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- batch:
+				batch, timer = nil, nil
+				return true
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer:
+				if !flush() {
+					return
+				}
+			case value, ok := <-ch:
+				if !ok {
+					flush()
+					return
+				}
+
+				if batch = append(batch, value); len(batch) == 1 && timeout > 0 {
+					timer = time.After(timeout)
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
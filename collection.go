@@ -0,0 +1,25 @@
+package collect
+
+import "iter"
+
+type Sized interface {
+	Len() int
+	Empty() bool
+}
+
+// Printable is implemented by the collections whose Print returns C for chaining.
+type Printable[C any] interface {
+	Print() C
+}
+
+type Iterable[E any] interface {
+	Values() iter.Seq[E]
+}
+
+// Collection is implemented by SliceCollection, NumberCollection and MapCollection,
+// T is the underlying slice or map, and E the type of its values.
+type Collection[T, E any] interface {
+	Sized
+	Iterable[E]
+	All() T
+}
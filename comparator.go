@@ -4,6 +4,7 @@ import (
 	"golang.org/x/exp/constraints"
 	"math"
 	"reflect"
+	"time"
 )
 
 func IsNumber(v any) bool {
@@ -124,7 +125,9 @@ type ComparisonSet struct {
 
 func (c *ComparisonSet) Normalize(v reflect.Value) (reflect.Kind, any) {
 	kind := v.Kind()
-	if kind == reflect.Slice || kind == reflect.Func || kind == reflect.Map {
+	if kind == reflect.Invalid {
+		return kind, nil
+	} else if kind == reflect.Slice || kind == reflect.Func || kind == reflect.Map {
 		return kind, v.UnsafePointer()
 	}
 
@@ -144,11 +147,13 @@ func (c *ComparisonSet) Normalize(v reflect.Value) (reflect.Kind, any) {
 
 func (c *ComparisonSet) Add(v any) {
 	kind, value := c.Normalize(reflect.ValueOf(v))
-	if _, ok := c.z[value]; !ok {
-		c.z[value] = make(map[reflect.Kind]struct{})
+	m, ok := c.z[value]
+	if !ok {
+		m = make(map[reflect.Kind]struct{})
+		c.z[value] = m
 	}
 
-	c.z[value][kind] = struct{}{}
+	m[kind] = struct{}{}
 }
 
 func (c *ComparisonSet) Has(v any) bool {
@@ -164,3 +169,71 @@ func (c *ComparisonSet) Has(v any) bool {
 func NewComparisonSet(looseNumber bool) *ComparisonSet {
 	return &ComparisonSet{looseNumber, make(map[any]map[reflect.Kind]struct{})}
 }
+
+func compareOrder(a, b any) (int, bool) {
+	if IsNumber(a) && IsNumber(b) {
+		ar, br := reflect.ValueOf(a), reflect.ValueOf(b)
+		switch true {
+		case ar.CanInt() && br.CanInt():
+			return cmpOrdered(ar.Int(), br.Int()), true
+		case ar.CanUint() && br.CanUint():
+			return cmpOrdered(ar.Uint(), br.Uint()), true
+		default:
+			af, _ := toFloat(ar)
+			bf, _ := toFloat(br)
+			return cmpOrdered(af, bf), true
+		}
+	}
+
+	switch av := a.(type) {
+	case string:
+		if bv, ok := b.(string); ok {
+			return cmpOrdered(av, bv), true
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			switch true {
+			case av == bv:
+				return 0, true
+			case bv:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			switch true {
+			case av.Before(bv):
+				return -1, true
+			case av.After(bv):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+
+	return 0, false
+}
+
+func cmpOrdered[T constraints.Ordered](a, b T) int {
+	switch true {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func toFloat(v reflect.Value) (float64, bool) {
+	switch true {
+	case v.CanInt():
+		return float64(v.Int()), true
+	case v.CanUint():
+		return float64(v.Uint()), true
+	case v.CanFloat():
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,54 @@
+package collect
+
+import "context"
+
+func EachCtx[T ~[]E, E any](ctx context.Context, items T, callback func(value E, index int)) error {
+	for index, item := range items {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		callback(item, index)
+	}
+	return nil
+}
+
+func MapCtx[T ~[]E, E any](ctx context.Context, items T, callback func(value E, index int) E) (T, error) {
+	mapped := make(T, len(items), cap(items))
+	err := EachCtx(ctx, items, func(value E, index int) {
+		mapped[index] = callback(value, index)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return mapped, nil
+}
+
+func FilterCtx[T ~[]E, E any](ctx context.Context, items T, callback func(value E, index int) bool) (T, error) {
+	var filtered T
+	err := EachCtx(ctx, items, func(value E, index int) {
+		if callback(value, index) {
+			filtered = append(filtered, value)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return filtered, nil
+}
+
+func ReduceCtx[T ~[]E, E any](ctx context.Context, items T, initial E, callback func(carry E, value E, key int) E) (E, error) {
+	err := EachCtx(ctx, items, func(value E, key int) {
+		initial = callback(initial, value, key)
+	})
+
+	if err != nil {
+		var zero E
+		return zero, err
+	}
+	return initial, nil
+}
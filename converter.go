@@ -108,3 +108,21 @@ func NumberFrom[N constraints.Integer | constraints.Float, T ~[]E, E any](c *Sli
 
 	return UseNumber[[]N, N](items)
 }
+
+func MapFrom[V comparable, T ~[]E, E any](c *SliceCollection[T, E], key any) *MapCollection[map[V]E, V, E] {
+	items := make(map[V]E)
+	for _, item := range c.All() {
+		if k, ok := keyOf[V](item, key); ok {
+			items[k] = item
+		}
+	}
+	return UseMap(items)
+}
+
+func SliceFrom[T ~map[K]V, K comparable, V any](c *MapCollection[T, K, V]) *SliceCollection[[]V, V] {
+	return UseSlice(SortedValues[T, K, V](c.All()))
+}
+
+func PairsFrom[T ~map[K]V, K comparable, V any](c *MapCollection[T, K, V]) *SliceCollection[[]Entry[K, V], Entry[K, V]] {
+	return UseSlice(SortByKey[T, K, V](c.All()))
+}
@@ -0,0 +1,192 @@
+package collect
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type RoundingMode int
+
+const (
+	RoundHalfUp RoundingMode = iota
+	RoundHalfEven
+	RoundHalfDown
+	RoundUp
+	RoundDown
+	RoundCeiling
+	RoundFloor
+)
+
+// Decimal is an immutable fixed-point number, its value is unscaled * 10^-scale.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	sign := int64(num.Sign() * den.Sign())
+	twice := new(big.Int).Abs(r)
+	half := twice.Lsh(twice, 1).Cmp(new(big.Int).Abs(den))
+
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfEven:
+		away = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case RoundHalfDown:
+		away = half > 0
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	default:
+		panic("unknown rounding mode")
+	}
+
+	if away {
+		q.Add(q, big.NewInt(sign))
+	}
+	return q
+}
+
+func NewDecimal(unscaled int64, scale int) Decimal {
+	if scale < 0 {
+		panic("scale must not be negative")
+	}
+	return Decimal{big.NewInt(unscaled), scale}
+}
+
+func ParseDecimal(s string) (Decimal, error) {
+	str, negative := s, false
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		str, negative = str[1:], str[0] == '-'
+	}
+
+	integer, fraction, _ := strings.Cut(str, ".")
+
+	digits := integer + fraction
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled, len(fraction)}, nil
+}
+
+func DecimalFromRat(r *big.Rat, scale int, mode RoundingMode) Decimal {
+	if scale < 0 {
+		panic("scale must not be negative")
+	}
+
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	return Decimal{roundQuo(num, r.Denom(), mode), scale}
+}
+
+func (d Decimal) Unscaled() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.unscaled)
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if scale < 0 {
+		panic("scale must not be negative")
+	} else if scale >= d.scale {
+		return Decimal{new(big.Int).Mul(d.Unscaled(), pow10(scale-d.scale)), scale}
+	}
+
+	return Decimal{roundQuo(d.Unscaled(), pow10(d.scale-scale), mode), scale}
+}
+
+func (d Decimal) align(target Decimal) (*big.Int, *big.Int, int) {
+	if d.scale >= target.scale {
+		return d.Unscaled(), target.Round(d.scale, RoundDown).unscaled, d.scale
+	}
+	return d.Round(target.scale, RoundDown).unscaled, target.Unscaled(), target.scale
+}
+
+func (d Decimal) Add(target Decimal) Decimal {
+	a, b, scale := d.align(target)
+	return Decimal{a.Add(a, b), scale}
+}
+
+func (d Decimal) Sub(target Decimal) Decimal {
+	a, b, scale := d.align(target)
+	return Decimal{a.Sub(a, b), scale}
+}
+
+func (d Decimal) Mul(target Decimal) Decimal {
+	return Decimal{new(big.Int).Mul(d.Unscaled(), target.Unscaled()), d.scale + target.scale}
+}
+
+func (d Decimal) Quo(target Decimal, scale int, mode RoundingMode) Decimal {
+	if target.Sign() == 0 {
+		panic("division by zero")
+	}
+	return DecimalFromRat(new(big.Rat).Quo(d.Rat(), target.Rat()), scale, mode)
+}
+
+func (d Decimal) Neg() Decimal {
+	u := d.Unscaled()
+	return Decimal{u.Neg(u), d.scale}
+}
+
+func (d Decimal) Sign() int {
+	if d.unscaled == nil {
+		return 0
+	}
+	return d.unscaled.Sign()
+}
+
+func (d Decimal) Cmp(target Decimal) int {
+	a, b, _ := d.align(target)
+	return a.Cmp(b)
+}
+
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.Unscaled(), pow10(d.scale))
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+func (d Decimal) String() string {
+	u := d.Unscaled()
+	digits := u.Abs(u).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
@@ -0,0 +1,193 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeRemoved
+	ChangeModified
+)
+
+type Change struct {
+	Kind ChangeKind
+	Path string
+	From any
+	To   any
+}
+
+type Changes []Change
+
+func (c Changes) String() string {
+	var sb strings.Builder
+	for _, change := range c {
+		path := change.Path
+		if path == "" {
+			path = "(root)"
+		}
+
+		switch change.Kind {
+		case ChangeAdded:
+			fmt.Fprintf(&sb, "+ %s: %v\n", path, change.To)
+		case ChangeRemoved:
+			fmt.Fprintf(&sb, "- %s: %v\n", path, change.From)
+		case ChangeModified:
+			fmt.Fprintf(&sb, "~ %s: %v -> %v\n", path, change.From, change.To)
+		}
+	}
+	return sb.String()
+}
+
+type differ struct {
+	changes Changes
+	visited map[[2]uintptr]struct{}
+}
+
+func joinPath(path string, key any) string {
+	if path == "" {
+		return fmt.Sprint(key)
+	}
+	return path + "." + fmt.Sprint(key)
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func (d *differ) add(kind ChangeKind, path string, from, to reflect.Value) {
+	d.changes = append(d.changes, Change{kind, path, interfaceOf(from), interfaceOf(to)})
+}
+
+func (d *differ) diff(path string, a, b reflect.Value) {
+	for a.IsValid() && a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.IsValid() && b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	switch true {
+	case !a.IsValid() && !b.IsValid():
+		return
+	case !a.IsValid() || !b.IsValid():
+		d.add(ChangeModified, path, a, b)
+		return
+	case a.Type() != b.Type():
+		if !compareNumeric(a.Interface(), "=", b.Interface()) {
+			d.add(ChangeModified, path, a, b)
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(ChangeModified, path, a, b)
+			}
+			return
+		}
+
+		pair := [2]uintptr{a.Pointer(), b.Pointer()}
+		if _, ok := d.visited[pair]; ok || pair[0] == pair[1] {
+			return
+		}
+		d.visited[pair] = struct{}{}
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Map:
+		if !a.IsNil() && !b.IsNil() {
+			pair := [2]uintptr{a.Pointer(), b.Pointer()}
+			if _, ok := d.visited[pair]; ok || pair[0] == pair[1] {
+				return
+			}
+			d.visited[pair] = struct{}{}
+		}
+
+		keys := append(a.MapKeys(), b.MapKeys()...)
+		sort.SliceStable(keys, func(i, j int) bool {
+			if c, ok := compareOrder(keys[i].Interface(), keys[j].Interface()); ok {
+				return c < 0
+			}
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		seen := NewComparisonSet(false)
+		for _, key := range keys {
+			if seen.Has(key.Interface()) {
+				continue
+			}
+			seen.Add(key.Interface())
+
+			av, bv := a.MapIndex(key), b.MapIndex(key)
+			switch true {
+			case !av.IsValid():
+				d.add(ChangeAdded, joinPath(path, key), av, bv)
+			case !bv.IsValid():
+				d.add(ChangeRemoved, joinPath(path, key), av, bv)
+			default:
+				d.diff(joinPath(path, key), av, bv)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			switch true {
+			case i >= a.Len():
+				d.add(ChangeAdded, joinPath(path, i), reflect.Value{}, b.Index(i))
+			case i >= b.Len():
+				d.add(ChangeRemoved, joinPath(path, i), a.Index(i), reflect.Value{})
+			default:
+				d.diff(joinPath(path, i), a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Struct:
+		var exported bool
+		for i := 0; i < a.NumField(); i++ {
+			if field := a.Type().Field(i); field.IsExported() {
+				exported = true
+				d.diff(joinPath(path, field.Name), a.Field(i), b.Field(i))
+			}
+		}
+
+		if !exported {
+			d.leaf(path, a, b)
+		}
+	default:
+		d.leaf(path, a, b)
+	}
+}
+
+func (d *differ) leaf(path string, a, b reflect.Value) {
+	av, bv := a.Interface(), b.Interface()
+
+	var equal bool
+	if IsNumber(av) {
+		equal = Compare(av, "=", bv)
+	} else if c, ok := compareOrder(av, bv); ok {
+		equal = c == 0
+	} else {
+		equal = reflect.DeepEqual(av, bv)
+	}
+
+	if !equal {
+		d.add(ChangeModified, path, a, b)
+	}
+}
+
+func DeepDiff(from, to any) Changes {
+	d := &differ{visited: make(map[[2]uintptr]struct{})}
+	d.diff("", reflect.ValueOf(from), reflect.ValueOf(to))
+	return d.changes
+}
+
+func MapDiff[T ~map[K]V, K comparable, V any](items, target T) Changes {
+	return DeepDiff(items, target)
+}
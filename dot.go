@@ -0,0 +1,169 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func pathKey(segment string, typ reflect.Type) (reflect.Value, bool) {
+	switch typ.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(segment).Convert(typ), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(segment, 10, typ.Bits()); err == nil {
+			return reflect.ValueOf(n).Convert(typ), true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseUint(segment, 10, typ.Bits()); err == nil {
+			return reflect.ValueOf(n).Convert(typ), true
+		}
+	case reflect.Interface:
+		return reflect.ValueOf(segment), true
+	}
+	return reflect.Value{}, false
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+func childOf(container reflect.Value, segment string) reflect.Value {
+	switch container.Kind() {
+	case reflect.Map:
+		if key, ok := pathKey(segment, container.Type().Key()); ok {
+			return container.MapIndex(key)
+		}
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < container.Len() {
+			return container.Index(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+func lookupPath(items any, path string) (reflect.Value, bool) {
+	current := reflect.ValueOf(items)
+	for _, segment := range strings.Split(path, ".") {
+		if current = childOf(indirect(current), segment); !current.IsValid() {
+			return current, false
+		}
+	}
+	return current, true
+}
+
+func setIn(container reflect.Value, segments []string, value any) {
+	switch container.Kind() {
+	case reflect.Map:
+		key, ok := pathKey(segments[0], container.Type().Key())
+		if !ok {
+			panic(fmt.Sprintf("path segment %q is not a valid key", segments[0]))
+		} else if len(segments) == 1 {
+			container.SetMapIndex(key, assignable(value, container.Type().Elem()))
+			return
+		}
+
+		child := indirect(container.MapIndex(key))
+		if !child.IsValid() || !(child.Kind() == reflect.Map && !child.IsNil() || child.Kind() == reflect.Slice) {
+			switch elem := container.Type().Elem(); elem.Kind() {
+			case reflect.Interface:
+				child = reflect.ValueOf(make(map[string]any))
+			case reflect.Map:
+				child = reflect.MakeMap(elem)
+			default:
+				panic(fmt.Sprintf("cannot create a map at path segment %q", segments[0]))
+			}
+			container.SetMapIndex(key, child)
+		}
+		setIn(child, segments[1:], value)
+	case reflect.Slice:
+		i, err := strconv.Atoi(segments[0])
+		if err != nil || i < 0 || i >= container.Len() {
+			panic(fmt.Sprintf("path index %q out of range", segments[0]))
+		} else if len(segments) == 1 {
+			container.Index(i).Set(assignable(value, container.Type().Elem()))
+			return
+		}
+		setIn(indirect(container.Index(i)), segments[1:], value)
+	default:
+		panic(fmt.Sprintf("cannot set path segment %q on %s", segments[0], container.Kind()))
+	}
+}
+
+func GetPath[T ~map[K]V, K comparable, V any](items T, path string) (any, bool) {
+	value, ok := lookupPath(items, path)
+	if !ok {
+		return nil, false
+	}
+	return value.Interface(), true
+}
+
+func HasPath[T ~map[K]V, K comparable, V any](items T, path string) bool {
+	_, ok := lookupPath(items, path)
+	return ok
+}
+
+func SetPath[T ~map[K]V, K comparable, V any](items T, path string, value any) T {
+	setIn(reflect.ValueOf(items), strings.Split(path, "."), value)
+	return items
+}
+
+func ForgetPath[T ~map[K]V, K comparable, V any](items T, path string) T {
+	segments := strings.Split(path, ".")
+	parent := reflect.ValueOf(items)
+	if len(segments) > 1 {
+		var ok bool
+		if parent, ok = lookupPath(items, strings.Join(segments[:len(segments)-1], ".")); !ok {
+			return items
+		}
+	}
+
+	if parent = indirect(parent); parent.Kind() == reflect.Map {
+		if key, ok := pathKey(segments[len(segments)-1], parent.Type().Key()); ok {
+			parent.SetMapIndex(key, reflect.Value{})
+		}
+	}
+	return items
+}
+
+func flatten(prefix string, v reflect.Value, flat map[string]any) {
+	if v = indirect(v); v.Kind() != reflect.Map || v.Len() == 0 {
+		flat[prefix] = v.Interface()
+		return
+	}
+
+	iter := v.MapRange()
+	for iter.Next() {
+		flatten(joinPath(prefix, iter.Key()), iter.Value(), flat)
+	}
+}
+
+func Dot[T ~map[K]V, K comparable, V any](items T) map[string]any {
+	flat := make(map[string]any)
+	for key, value := range items {
+		flatten(fmt.Sprint(key), reflect.ValueOf(&value).Elem(), flat)
+	}
+	return flat
+}
+
+func Undot[T ~map[K]V, K comparable, V any](items T) map[string]any {
+	paths := make([]string, 0, len(items))
+	values := make(map[string]any, len(items))
+	for key, value := range items {
+		path := fmt.Sprint(key)
+		paths = append(paths, path)
+		values[path] = value
+	}
+
+	sort.Strings(paths)
+	nested := make(map[string]any)
+	for _, path := range paths {
+		SetPath(nested, path, values[path])
+	}
+	return nested
+}
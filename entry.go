@@ -0,0 +1,61 @@
+package collect
+
+import (
+	"fmt"
+	"sort"
+)
+
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// lessAny orders the values by compareOrder, and falls back to their printed form.
+func lessAny(a, b any) bool {
+	if c, ok := compareOrder(a, b); ok {
+		return c < 0
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func SortedKeys[T ~map[K]V, K comparable, V any](items T) []K {
+	keys := make([]K, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessAny(keys[i], keys[j])
+	})
+	return keys
+}
+
+func SortedValues[T ~map[K]V, K comparable, V any](items T) []V {
+	values := make([]V, 0, len(items))
+	for _, key := range SortedKeys(items) {
+		values = append(values, items[key])
+	}
+	return values
+}
+
+func EachSorted[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K)) {
+	for _, key := range SortedKeys(items) {
+		callback(items[key], key)
+	}
+}
+
+func SortByKey[T ~map[K]V, K comparable, V any](items T) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(items))
+	for _, key := range SortedKeys(items) {
+		entries = append(entries, Entry[K, V]{key, items[key]})
+	}
+	return entries
+}
+
+func SortByValue[T ~map[K]V, K comparable, V any](items T) []Entry[K, V] {
+	entries := SortByKey(items)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return lessAny(entries[i].Value, entries[j].Value)
+	})
+	return entries
+}
@@ -1,13 +1,14 @@
 package collect
 
 import (
+	"cmp"
 	"github.com/sxyazi/go-collection/types"
 	"golang.org/x/exp/constraints"
+	"maps"
 	"math"
-	"math/rand"
 	"reflect"
+	"slices"
 	"sort"
-	"time"
 )
 
 /**
@@ -63,6 +64,8 @@ func Last[T ~[]E, E any](items T) (E, bool) {
 func Index[T ~[]E, E any](items T, target E) int {
 	if len(items) == 0 {
 		return -1
+	} else if index, ok := primitiveIndex([]E(items), target); ok {
+		return index
 	}
 
 	for index, item := range items {
@@ -79,14 +82,19 @@ func Contains[T ~[]E, E any](items T, item E) bool {
 }
 
 func Diff[T ~[]E, E any](items, target T) T {
-	var different T
-	for _, item := range items {
-		if Index(target, item) == -1 {
-			different = append(different, item)
-		}
+	if different, ok := primitiveFilter([]E(items), []E(target), false); ok {
+		return different
+	}
+
+	return filterByComparisonSet(items, target, false)
+}
+
+func Intersect[T ~[]E, E any](items, target T) T {
+	if intersected, ok := primitiveFilter([]E(items), []E(target), true); ok {
+		return intersected
 	}
 
-	return different
+	return filterByComparisonSet(items, target, true)
 }
 
 func Filter[T ~[]E, E any](items T, callback func(value E, index int) bool) T {
@@ -148,26 +156,26 @@ func Merge[T ~[]E, E any](items T, targets ...T) T {
 	return items
 }
 
-func Random[T ~[]E, E any](items T) (E, bool) {
+func Random[T ~[]E, E any](items T, source ...RandomSource) (E, bool) {
 	if len(items) == 0 {
 		var zero E
 		return zero, false
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	return items[rand.Intn(len(items))], true
+	return items[randomSource(source).Intn(len(items))], true
 }
 
 func Reverse[T ~[]E, E any](items T) T {
-	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
-		items[i], items[j] = items[j], items[i]
-	}
+	slices.Reverse(items)
 	return items
 }
 
-func Shuffle[T ~[]E, E any](items T) T {
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+func Shuffle[T ~[]E, E any](items T, source ...RandomSource) T {
+	src := randomSource(source)
+	for i := len(items) - 1; i > 0; i-- {
+		j := src.Intn(i + 1)
+		items[i], items[j] = items[j], items[i]
+	}
 	return items
 }
 
@@ -242,16 +250,12 @@ func Push[T ~[]E, E any](items *T, item E) T {
 	return *items
 }
 
-func Where[T ~[]E, E any](items T, args ...any) T {
-	if len(args) < 1 {
-		return items
-	}
-
+func wherePredicate(args ...any) func(value any) bool {
 	// Where(target any)
 	if len(args) == 1 {
-		return Filter(items, func(value E, _ int) bool {
+		return func(value any) bool {
 			return Compare(value, "=", args[0])
-		})
+		}
 	}
 
 	var operator string
@@ -282,7 +286,7 @@ func Where[T ~[]E, E any](items T, args ...any) T {
 		}
 	}
 
-	return Filter[T, E](items, func(value E, _ int) bool {
+	return func(value any) bool {
 		if key == nil {
 			return Compare(value, operator, target)
 		} else if c, err := AnyGet[any](value, key); err == nil {
@@ -290,6 +294,17 @@ func Where[T ~[]E, E any](items T, args ...any) T {
 		}
 
 		return false
+	}
+}
+
+func Where[T ~[]E, E any](items T, args ...any) T {
+	if len(args) < 1 {
+		return items
+	}
+
+	predicate := wherePredicate(args...)
+	return Filter[T, E](items, func(value E, _ int) bool {
+		return predicate(value)
 	})
 }
 
@@ -349,6 +364,14 @@ func WhereNotIn[T ~[]E, E any](items T, args ...any) T {
  */
 
 func Sum[T ~[]E, E constraints.Integer | constraints.Float](items T) (total E) {
+	if isFloat[E]() {
+		var s compensatedSum
+		for _, value := range items {
+			s.Add(float64(value))
+		}
+		return E(s.Result())
+	}
+
 	for _, value := range items {
 		total += value
 	}
@@ -386,21 +409,31 @@ func Max[T ~[]E, E constraints.Integer | constraints.Float](items T) E {
 }
 
 func Sort[T ~[]E, E constraints.Ordered](items T) T {
-	sort.Sort(&types.SortableSlice[T, E]{items, false})
+	slices.Sort(items)
 	return items
 }
 
 func SortDesc[T ~[]E, E constraints.Ordered](items T) T {
-	sort.Sort(&types.SortableSlice[T, E]{items, true})
+	slices.SortFunc(items, func(a, b E) int {
+		return cmp.Compare(b, a)
+	})
 	return items
 }
 
+func BinarySearch[T ~[]E, E cmp.Ordered](items T, target E) (int, bool) {
+	return slices.BinarySearch(items, target)
+}
+
 func Avg[T ~[]E, E constraints.Integer | constraints.Float](items T) float64 {
 	if len(items) == 0 {
 		return 0
 	}
 
-	return float64(Sum[T, E](items)) / float64(len(items))
+	var s compensatedSum
+	for _, value := range items {
+		s.Add(float64(value))
+	}
+	return s.Result() / float64(len(items))
 }
 
 func Median[T ~[]E, E constraints.Integer | constraints.Float](items T) float64 {
@@ -417,7 +450,132 @@ func Median[T ~[]E, E constraints.Integer | constraints.Float](items T) float64
 		return float64(replica[half])
 	}
 
-	return float64(replica[half-1]+replica[half]) / 2
+	var s compensatedSum
+	s.Add(float64(replica[half-1]))
+	s.Add(float64(replica[half]))
+	return s.Result() / 2
+}
+
+func cumulate[T ~[]E, E constraints.Integer | constraints.Float](items T, callback func(carry E, value E) E) T {
+	result := make(T, len(items))
+	for index, item := range items {
+		if index == 0 {
+			result[index] = item
+		} else {
+			result[index] = callback(result[index-1], item)
+		}
+	}
+	return result
+}
+
+func CumSum[T ~[]E, E constraints.Integer | constraints.Float](items T) T {
+	return cumulate(items, func(carry E, value E) E { return carry + value })
+}
+
+func CumProd[T ~[]E, E constraints.Integer | constraints.Float](items T) T {
+	return cumulate(items, func(carry E, value E) E { return carry * value })
+}
+
+func CumMax[T ~[]E, E constraints.Integer | constraints.Float](items T) T {
+	return cumulate(items, func(carry E, value E) E {
+		if value > carry {
+			return value
+		}
+		return carry
+	})
+}
+
+func CumMin[T ~[]E, E constraints.Integer | constraints.Float](items T) T {
+	return cumulate(items, func(carry E, value E) E {
+		if value < carry {
+			return value
+		}
+		return carry
+	})
+}
+
+// Delta returns float64 so that decreasing unsigned numbers do not wrap around.
+func Delta[T ~[]E, E constraints.Integer | constraints.Float](items T, lag int) []float64 {
+	if lag < 1 {
+		panic("lag must be positive")
+	} else if lag >= len(items) {
+		return make([]float64, 0)
+	}
+
+	result := make([]float64, len(items)-lag)
+	for i := range result {
+		result[i] = float64(items[i+lag]) - float64(items[i])
+	}
+	return result
+}
+
+func PctChange[T ~[]E, E constraints.Integer | constraints.Float](items T) []float64 {
+	if len(items) < 2 {
+		return make([]float64, 0)
+	}
+
+	result := make([]float64, len(items)-1)
+	for i := range result {
+		prev := float64(items[i])
+		result[i] = (float64(items[i+1]) - prev) / prev
+	}
+	return result
+}
+
+func Normalize[T ~[]E, E constraints.Integer | constraints.Float](items T) []float64 {
+	min, max := float64(Min[T, E](items)), float64(Max[T, E](items))
+
+	result := make([]float64, len(items))
+	if min == max {
+		return result
+	}
+
+	for i, item := range items {
+		result[i] = (float64(item) - min) / (max - min)
+	}
+	return result
+}
+
+func Standardize[T ~[]E, E constraints.Integer | constraints.Float](items T) []float64 {
+	result := make([]float64, len(items))
+	if len(items) == 0 {
+		return result
+	}
+
+	mean := Avg[T, E](items)
+	var variance compensatedSum
+	for _, item := range items {
+		variance.Add((float64(item) - mean) * (float64(item) - mean))
+	}
+
+	std := math.Sqrt(variance.Result() / float64(len(items)))
+	if std == 0 {
+		return result
+	}
+
+	for i, item := range items {
+		result[i] = (float64(item) - mean) / std
+	}
+	return result
+}
+
+func Clamp[T ~[]E, E constraints.Integer | constraints.Float](items T, lower, upper E) T {
+	if lower > upper {
+		panic("lower bound must not be greater than upper bound")
+	}
+
+	result := make(T, len(items))
+	for i, item := range items {
+		switch true {
+		case item < lower:
+			result[i] = lower
+		case item > upper:
+			result[i] = upper
+		default:
+			result[i] = item
+		}
+	}
+	return result
 }
 
 /**
@@ -448,11 +606,8 @@ func Except[T ~map[K]V, K comparable, V any](items T, keys ...K) T {
 	return m
 }
 
-func Keys[T ~map[K]V, K comparable, V any](items T) (keys []K) {
-	for key := range items {
-		keys = append(keys, key)
-	}
-	return
+func Keys[T ~map[K]V, K comparable, V any](items T) []K {
+	return slices.Collect(maps.Keys(items))
 }
 
 func DiffKeys[T ~map[K]V, K comparable, V any](items T, target T) T {
@@ -521,9 +676,7 @@ func MapSame[T ~map[K]V, K comparable, V any](items, target T) bool {
 
 func MapMerge[T ~map[K]V, K comparable, V any](items T, targets ...T) T {
 	for _, target := range targets {
-		for key, value := range target {
-			items[key] = value
-		}
+		maps.Copy(items, target)
 	}
 	return items
 }
@@ -537,6 +690,108 @@ func Union[T ~map[K]V, K comparable, V any](items T, target T) T {
 	return items
 }
 
+func MapEach[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K)) {
+	for key, value := range items {
+		callback(value, key)
+	}
+}
+
+func MapFilter[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) bool) T {
+	filtered := make(T)
+	for key, value := range items {
+		if callback(value, key) {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+func MapFilterKeys[T ~map[K]V, K comparable, V any](items T, callback func(key K) bool) T {
+	return MapFilter(items, func(_ V, key K) bool {
+		return callback(key)
+	})
+}
+
+func MapValues[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) V) T {
+	mapped := make(T, len(items))
+	for key, value := range items {
+		mapped[key] = callback(value, key)
+	}
+	return mapped
+}
+
+func MapKeys[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) K) T {
+	mapped := make(T, len(items))
+	for key, value := range items {
+		mapped[callback(value, key)] = value
+	}
+	return mapped
+}
+
+func MapReduce[T ~map[K]V, K comparable, V any](items T, initial V, callback func(carry V, value V, key K) V) V {
+	for key, value := range items {
+		initial = callback(initial, value, key)
+	}
+	return initial
+}
+
+func MapWhere[T ~map[K]V, K comparable, V any](items T, args ...any) T {
+	if len(args) < 1 {
+		return items
+	}
+
+	predicate := wherePredicate(args...)
+	return MapFilter(items, func(value V, _ K) bool {
+		return predicate(value)
+	})
+}
+
+func MapEvery[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) bool) bool {
+	for key, value := range items {
+		if !callback(value, key) {
+			return false
+		}
+	}
+	return true
+}
+
+func MapSome[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) bool) bool {
+	for key, value := range items {
+		if callback(value, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func MapPartition[T ~map[K]V, K comparable, V any](items T, callback func(value V, key K) bool) (T, T) {
+	passed, failed := make(T), make(T)
+	for key, value := range items {
+		if callback(value, key) {
+			passed[key] = value
+		} else {
+			failed[key] = value
+		}
+	}
+	return passed, failed
+}
+
+func Flip[T ~map[K]V, K, V comparable](items T) map[V]K {
+	flipped := make(map[V]K, len(items))
+	for key, value := range items {
+		flipped[value] = key
+	}
+	return flipped
+}
+
+func Invert[T ~map[K]V, K, V comparable](items T) map[V][]K {
+	inverted := make(map[V][]K)
+	for key, value := range items {
+		inverted[value] = append(inverted[value], key)
+	}
+	return inverted
+}
+
 /**
  * Standalone
  */
@@ -579,13 +834,13 @@ func Times[T []E, E any](number int, callback func(number int) E) *SliceCollecti
 func sortBy[T ~[]E, E any, C func(item E, index int) R, R constraints.Ordered](items T, desc bool, callback C) *SliceCollection[T, E] {
 	structs := make([]*types.SortableStruct[R], len(items))
 	for index, item := range items {
-		structs[index] = &types.SortableStruct[R]{callback(item, index), index}
+		structs[index] = &types.SortableStruct[R]{Value: callback(item, index), Attached: index}
 	}
 
 	replica := make(T, len(items))
 	copy(replica, items)
 
-	sort.Sort(&types.SortableStructs[[]R, R]{structs, desc})
+	sort.Sort(&types.SortableStructs[[]R, R]{Items: structs, Desc: desc})
 	for index, s := range structs {
 		items[index] = replica[s.Attached.(int)]
 	}
@@ -1,5 +1,5 @@
 module github.com/sxyazi/go-collection
 
-go 1.18
+go 1.23
 
 require golang.org/x/exp v0.0.0-20220205015713-f5f519d967d6
@@ -0,0 +1,130 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type GroupedCollection[V comparable, I any] struct {
+	keys   []V
+	groups map[V][]I
+}
+
+type AggregateRow[V comparable] struct {
+	Key    V
+	Values map[string]any
+}
+
+func UseGroupBy[V comparable, K, I any](items []I, key K) *GroupedCollection[V, I] {
+	g := &GroupedCollection[V, I]{groups: make(map[V][]I)}
+	for _, item := range items {
+		if v, err := AnyGet[V](item, key); err == nil {
+			g.add(v, item)
+		}
+	}
+	return g
+}
+
+func UseGroupByMissing[V comparable, K, I any](items []I, key K, bucket V) *GroupedCollection[V, I] {
+	g := &GroupedCollection[V, I]{groups: make(map[V][]I)}
+	for _, item := range items {
+		if v, err := AnyGet[V](item, key); err == nil {
+			g.add(v, item)
+		} else {
+			g.add(bucket, item)
+		}
+	}
+	return g
+}
+
+func UseGroupByFunc[V comparable, I any](items []I, callback func(value I, index int) V) *GroupedCollection[V, I] {
+	g := &GroupedCollection[V, I]{groups: make(map[V][]I)}
+	for index, item := range items {
+		g.add(callback(item, index), item)
+	}
+	return g
+}
+
+func (g *GroupedCollection[V, I]) add(key V, item I) {
+	if _, ok := g.groups[key]; !ok {
+		g.keys = append(g.keys, key)
+	}
+	g.groups[key] = append(g.groups[key], item)
+}
+
+func (g *GroupedCollection[V, I]) All() map[V][]I {
+	return g.groups
+}
+
+func (g *GroupedCollection[V, I]) Keys() []V {
+	return g.keys
+}
+
+func (g *GroupedCollection[V, I]) Len() int {
+	return len(g.keys)
+}
+
+func (g *GroupedCollection[V, I]) Empty() bool {
+	return len(g.keys) == 0
+}
+
+func (g *GroupedCollection[V, I]) Print() *GroupedCollection[V, I] {
+	fmt.Println(g.groups)
+	return g
+}
+
+func (g *GroupedCollection[V, I]) Get(key V) ([]I, bool) {
+	items, ok := g.groups[key]
+	return items, ok
+}
+
+func (g *GroupedCollection[V, I]) Each(callback func(items []I, key V)) *GroupedCollection[V, I] {
+	for _, key := range g.keys {
+		callback(g.groups[key], key)
+	}
+	return g
+}
+
+func (g *GroupedCollection[V, I]) Having(aggregator Aggregator, operator string, target any) *GroupedCollection[V, I] {
+	keys := g.keys[:0:0]
+	for _, key := range g.keys {
+		if compareNumeric(aggregate(aggregator, g.groups[key]), operator, target) {
+			keys = append(keys, key)
+		} else {
+			delete(g.groups, key)
+		}
+	}
+
+	g.keys = keys
+	return g
+}
+
+func (g *GroupedCollection[V, I]) Aggregate(aggregators ...Aggregator) []AggregateRow[V] {
+	names := make(map[string]struct{}, len(aggregators))
+	for _, aggregator := range aggregators {
+		if _, ok := names[aggregator.Name]; ok {
+			panic(fmt.Sprintf("duplicate aggregator name %q, use As to rename it", aggregator.Name))
+		}
+		names[aggregator.Name] = struct{}{}
+	}
+
+	rows := make([]AggregateRow[V], len(g.keys))
+	for i, key := range g.keys {
+		rows[i] = AggregateRow[V]{key, make(map[string]any, len(aggregators))}
+		for _, aggregator := range aggregators {
+			rows[i].Values[aggregator.Name] = aggregate(aggregator, g.groups[key])
+		}
+	}
+	return rows
+}
+
+// compareNumeric compares numbers of different kinds by their float64 values,
+// so that e.g. a float sum can be compared with an int literal.
+func compareNumeric(a any, operator string, b any) bool {
+	if x, ok := toFloat(reflect.ValueOf(a)); ok {
+		if y, ok := toFloat(reflect.ValueOf(b)); ok {
+			return Compare(x, operator, y)
+		}
+	}
+	return Compare(a, operator, b)
+}
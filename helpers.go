@@ -106,6 +106,64 @@ func GroupBy[V comparable, K, I any](items []I, key K) map[V][]I {
 	return result
 }
 
+func GroupByFunc[V comparable, I any](items []I, callback func(value I, index int) V) map[V][]I {
+	result := make(map[V][]I)
+	for index, item := range items {
+		v := callback(item, index)
+		result[v] = append(result[v], item)
+	}
+	return result
+}
+
+func GroupByKeys[K, I any](items []I, keys ...K) map[any][]I {
+	return groupByKeys(items, keys, false, nil)
+}
+
+func GroupByKeysMissing[K, I any](items []I, bucket any, keys ...K) map[any][]I {
+	if bucket != nil && !reflect.TypeOf(bucket).Comparable() {
+		panic("bucket must be comparable")
+	}
+	return groupByKeys(items, keys, true, bucket)
+}
+
+func groupByKeys[K, I any](items []I, keys []K, missing bool, bucket any) map[any][]I {
+	result := make(map[any][]I)
+	typ := reflect.ArrayOf(len(keys), reflect.TypeOf((*any)(nil)).Elem())
+
+next:
+	for _, item := range items {
+		composite := reflect.New(typ).Elem()
+		for i, key := range keys {
+			v, err := AnyGet[any](item, key)
+			if err != nil || (v != nil && !reflect.TypeOf(v).Comparable()) {
+				if !missing {
+					continue next
+				}
+				v = bucket
+			}
+			if v != nil {
+				composite.Index(i).Set(reflect.ValueOf(v))
+			}
+		}
+
+		k := composite.Interface()
+		result[k] = append(result[k], item)
+	}
+	return result
+}
+
+func GroupByMissing[V comparable, K, I any](items []I, key K, bucket V) map[V][]I {
+	result := make(map[V][]I)
+	for _, item := range items {
+		if v, err := AnyGet[V](item, key); err == nil {
+			result[v] = append(result[v], item)
+		} else {
+			result[bucket] = append(result[bucket], item)
+		}
+	}
+	return result
+}
+
 func MapGroupBy[K, V comparable](items []map[K]V, key K) map[V][]map[K]V {
 	result := make(map[V][]map[K]V)
 	for _, item := range items {
@@ -113,3 +171,14 @@ func MapGroupBy[K, V comparable](items []map[K]V, key K) map[V][]map[K]V {
 	}
 	return result
 }
+
+func keyOf[K comparable, I any](item I, key any) (K, bool) {
+	if callback, ok := key.(func(item I) K); ok {
+		return callback(item), true
+	} else if key != nil && reflect.TypeOf(key).Kind() == reflect.Func {
+		panic(fmt.Sprintf("key callback must be of type %T, got %T", callback, key))
+	}
+
+	v, err := AnyGet[K](item, key)
+	return v, err == nil
+}
@@ -0,0 +1,188 @@
+package collect
+
+import (
+	"golang.org/x/exp/constraints"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// binCount accepts the number of bins as any integer kind, such as int64 or uint.
+func binCount(ref reflect.Value) int {
+	switch {
+	case ref.CanInt() && ref.Int() >= 1 && ref.Int() <= math.MaxInt:
+		return int(ref.Int())
+	case ref.CanUint() && ref.Uint() >= 1 && ref.Uint() <= math.MaxInt:
+		return int(ref.Uint())
+	}
+	panic("number of bins must be positive")
+}
+
+func binEdges(values []float64, bins any) []float64 {
+	ref := reflect.ValueOf(bins)
+	if ref.CanInt() || ref.CanUint() {
+		n := binCount(ref)
+
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, v := range values {
+			// NaN and ±Inf would turn every edge into NaN or Inf, they fall outside all bins instead
+			if !math.IsNaN(v) && !math.IsInf(v, 0) {
+				lo, hi = math.Min(lo, v), math.Max(hi, v)
+			}
+		}
+
+		if lo > hi {
+			lo, hi = 0, 1
+		} else if lo == hi {
+			lo, hi = lo-0.5, hi+0.5
+		}
+
+		edges := make([]float64, n+1)
+		for i := range edges {
+			edges[i] = lo + (hi-lo)*float64(i)/float64(n)
+		}
+		edges[n] = hi
+		return edges
+	}
+
+	if ref.Kind() != reflect.Slice && ref.Kind() != reflect.Array {
+		panic("bins type error")
+	} else if ref.Len() < 2 {
+		panic("at least two bin edges are required")
+	}
+
+	edges := make([]float64, ref.Len())
+	for i := range edges {
+		switch v := ref.Index(i); true {
+		case v.CanInt():
+			edges[i] = float64(v.Int())
+		case v.CanUint():
+			edges[i] = float64(v.Uint())
+		case v.CanFloat():
+			edges[i] = v.Float()
+		default:
+			panic("bins type error")
+		}
+
+		if i > 0 && edges[i] < edges[i-1] {
+			panic("bin edges must increase monotonically")
+		}
+	}
+
+	return edges
+}
+
+func binIndex(edges []float64, v float64) int {
+	if math.IsNaN(v) {
+		return -1
+	}
+
+	last := len(edges) - 1
+	index := sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+	if index == last && v == edges[last] {
+		index--
+	}
+
+	if index < 0 || index >= last {
+		return -1
+	}
+	return index
+}
+
+func quantile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	if lo >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+
+	return sorted[lo] + (sorted[lo+1]-sorted[lo])*(pos-float64(lo))
+}
+
+func toFloats[T ~[]E, E constraints.Integer | constraints.Float](items T) []float64 {
+	values := make([]float64, len(items))
+	for i, item := range items {
+		values[i] = float64(item)
+	}
+	return values
+}
+
+func Histogram[T ~[]E, E constraints.Integer | constraints.Float](items T, bins any) ([]int, []float64) {
+	values := toFloats[T, E](items)
+	edges := binEdges(values, bins)
+
+	counts := make([]int, len(edges)-1)
+	for _, v := range values {
+		if i := binIndex(edges, v); i != -1 {
+			counts[i]++
+		}
+	}
+
+	return counts, edges
+}
+
+func Cut[T ~[]E, E constraints.Integer | constraints.Float](items T, bins any) []int {
+	values := toFloats[T, E](items)
+	edges := binEdges(values, bins)
+
+	labels := make([]int, len(values))
+	for i, v := range values {
+		labels[i] = binIndex(edges, v)
+	}
+	return labels
+}
+
+func QCut[T ~[]E, E constraints.Integer | constraints.Float](items T, q int) []int {
+	if q < 1 {
+		panic("number of quantiles must be positive")
+	}
+
+	values := toFloats[T, E](items)
+	sorted := Filter(values, func(v float64, _ int) bool { return !math.IsNaN(v) })
+	sort.Float64s(sorted)
+
+	labels := make([]int, len(values))
+	if len(sorted) == 0 {
+		for i := range labels {
+			labels[i] = -1
+		}
+		return labels
+	}
+
+	edges := make([]float64, q+1)
+	for i := range edges {
+		edges[i] = quantile(sorted, float64(i)/float64(q))
+	}
+
+	// Repeated edges would leave zero-width bins, so they are dropped and fewer than q bins may remain
+	if edges = slices.Compact(edges); len(edges) == 1 {
+		edges = append(edges, edges[0])
+	}
+
+	for i, v := range values {
+		labels[i] = binIndex(edges, v)
+	}
+	return labels
+}
+
+func BinBy[T ~[]E, E any](items T, bins any, callback func(value E, index int) float64) []T {
+	values := make([]float64, len(items))
+	for index, item := range items {
+		values[index] = callback(item, index)
+	}
+
+	edges := binEdges(values, bins)
+	binned := make([]T, len(edges)-1)
+	for index, v := range values {
+		if i := binIndex(edges, v); i != -1 {
+			binned[i] = append(binned[i], items[index])
+		}
+	}
+
+	return binned
+}
@@ -0,0 +1,100 @@
+package collect
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FromLines streams the lines of the reader without their line endings, lines are not limited in length.
+func FromLines(r io.Reader) *Stream[string] {
+	reader := bufio.NewReader(r)
+	return NewStream(func() (string, error) {
+		line, err := reader.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", err
+		}
+		return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+	})
+}
+
+func FromJSONLines[T any](r io.Reader) *Stream[T] {
+	decoder, record := json.NewDecoder(r), 0
+	return NewStream(func() (T, error) {
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			if errors.Is(err, io.EOF) {
+				return value, err
+			}
+			return value, fmt.Errorf("record %d: %w", record, err)
+		}
+
+		record++
+		return value, nil
+	})
+}
+
+func ChunkStream[E any](s *Stream[E], size int) *Stream[[]E] {
+	if size < 1 {
+		panic("chunk size must be positive")
+	}
+
+	chunks := NewStream(func() ([]E, error) {
+		var chunk []E
+		for len(chunk) < size {
+			value, ok := s.Next()
+			if !ok {
+				break
+			}
+			chunk = append(chunk, value)
+		}
+
+		// A partial chunk is emitted first, the error of the source surfaces on the next pull
+		if len(chunk) > 0 {
+			return chunk, nil
+		} else if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	})
+	chunks.ctx = s.ctx
+	return chunks
+}
+
+func writeStream[E any](w io.Writer, s *Stream[E], write func(writer *bufio.Writer, value E) error) (int, error) {
+	writer := bufio.NewWriter(w)
+
+	var written int
+	for value, ok := s.Next(); ok; value, ok = s.Next() {
+		if err := write(writer, value); err != nil {
+			return written, err
+		}
+		written++
+	}
+
+	err := writer.Flush()
+	if s.Err() != nil {
+		err = s.Err()
+	}
+	return written, err
+}
+
+func WriteLines[E any](w io.Writer, s *Stream[E]) (int, error) {
+	return writeStream(w, s, func(writer *bufio.Writer, value E) error {
+		_, err := fmt.Fprintln(writer, value)
+		return err
+	})
+}
+
+func WriteJSONLines[E any](w io.Writer, s *Stream[E]) (int, error) {
+	var encoder *json.Encoder
+	return writeStream(w, s, func(writer *bufio.Writer, value E) error {
+		if encoder == nil {
+			encoder = json.NewEncoder(writer)
+		}
+		return encoder.Encode(value)
+	})
+}
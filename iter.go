@@ -0,0 +1,82 @@
+package collect
+
+import "iter"
+
+func FromSeq[E any](seq iter.Seq[E]) *SliceCollection[[]E, E] {
+	var items []E
+	for value := range seq {
+		items = append(items, value)
+	}
+	return UseSlice(items)
+}
+
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) *MapCollection[map[K]V, K, V] {
+	items := make(map[K]V)
+	for key, value := range seq {
+		items[key] = value
+	}
+	return UseMap(items)
+}
+
+func (s *SliceCollection[T, E]) Values() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, item := range s.z {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SliceCollection[T, E]) Pairs() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for index, item := range s.z {
+			if !yield(index, item) {
+				return
+			}
+		}
+	}
+}
+
+func (m *MapCollection[T, K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m.z {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *MapCollection[T, K, V]) Pairs() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range m.z {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Values consumes the stream, check Err after the iteration for the error that stopped it.
+func (s *Stream[E]) Values() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for value, ok := s.Next(); ok; value, ok = s.Next() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Stream[E]) Pairs() iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		index := 0
+		for value, ok := s.Next(); ok; value, ok = s.Next() {
+			if !yield(index, value) {
+				return
+			}
+			index++
+		}
+	}
+}
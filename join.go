@@ -0,0 +1,94 @@
+package collect
+
+type JoinPair[L, R any] struct {
+	Left  *L
+	Right *R
+}
+
+func indexBy[K comparable, I any](items []I, key any) map[K][]int {
+	index := make(map[K][]int)
+	for i, item := range items {
+		if k, ok := keyOf[K](item, key); ok {
+			index[k] = append(index[k], i)
+		}
+	}
+	return index
+}
+
+func join[K comparable, L, R any](left []L, right []R, leftKey, rightKey any, keepLeft, keepRight bool) []JoinPair[L, R] {
+	index := indexBy[K](right, rightKey)
+	matched := make([]bool, len(right))
+
+	var pairs []JoinPair[L, R]
+	for i := range left {
+		k, ok := keyOf[K](left[i], leftKey)
+		if js := index[k]; ok && len(js) > 0 {
+			for _, j := range js {
+				pairs = append(pairs, JoinPair[L, R]{&left[i], &right[j]})
+				matched[j] = true
+			}
+		} else if keepLeft {
+			pairs = append(pairs, JoinPair[L, R]{&left[i], nil})
+		}
+	}
+
+	if keepRight {
+		for j := range right {
+			if !matched[j] {
+				pairs = append(pairs, JoinPair[L, R]{nil, &right[j]})
+			}
+		}
+	}
+	return pairs
+}
+
+func Join[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []JoinPair[L, R] {
+	return join[K](left, right, leftKey, rightKey, false, false)
+}
+
+func LeftJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []JoinPair[L, R] {
+	return join[K](left, right, leftKey, rightKey, true, false)
+}
+
+func RightJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []JoinPair[L, R] {
+	flipped := join[K](right, left, rightKey, leftKey, true, false)
+
+	pairs := make([]JoinPair[L, R], len(flipped))
+	for i, pair := range flipped {
+		pairs[i] = JoinPair[L, R]{pair.Right, pair.Left}
+	}
+	return pairs
+}
+
+func FullOuterJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []JoinPair[L, R] {
+	return join[K](left, right, leftKey, rightKey, true, true)
+}
+
+func semiJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any, anti bool) []L {
+	index := indexBy[K](right, rightKey)
+
+	var result []L
+	for _, item := range left {
+		k, ok := keyOf[K](item, leftKey)
+		if _, found := index[k]; (ok && found) != anti {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func SemiJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []L {
+	return semiJoin[K](left, right, leftKey, rightKey, false)
+}
+
+func AntiJoin[K comparable, L, R any](left []L, right []R, leftKey, rightKey any) []L {
+	return semiJoin[K](left, right, leftKey, rightKey, true)
+}
+
+func JoinSelect[L, R, O any](pairs []JoinPair[L, R], selector func(left *L, right *R) O) []O {
+	result := make([]O, len(pairs))
+	for i, pair := range pairs {
+		result[i] = selector(pair.Left, pair.Right)
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package collect
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+func filterBySet[E comparable](items, target []E, keep bool) []E {
+	set := make(map[E]struct{}, len(target))
+	for _, item := range target {
+		set[item] = struct{}{}
+	}
+
+	var filtered []E
+	for _, item := range items {
+		if _, ok := set[item]; ok == keep {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// filterByTolerance sorts the target once and binary-searches each item, so that
+// the 1e-9 tolerance of Compare costs O(log m) per item instead of a linear scan.
+func filterByTolerance[F float32 | float64](items, target []F, keep bool) []F {
+	var nan bool
+	sorted := make([]float64, 0, len(target))
+	for _, v := range target {
+		if math.IsNaN(float64(v)) {
+			nan = true
+		} else {
+			sorted = append(sorted, float64(v))
+		}
+	}
+	slices.Sort(sorted)
+
+	// Compare treats NaN as equal to NaN only for float64
+	_, nanEqual := any(items).([]float64)
+
+	var filtered []F
+	for _, item := range items {
+		v, found := float64(item), false
+		if math.IsNaN(v) {
+			found = nan && nanEqual
+		} else if i := sort.SearchFloat64s(sorted, v-1e-9); i < len(sorted) && math.Abs(sorted[i]-v) <= 1e-9 {
+			found = true
+		} else if i > 0 && math.Abs(sorted[i-1]-v) <= 1e-9 {
+			found = true
+		}
+
+		if found == keep {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// scalarKind reports whether == on E agrees with Compare even if E is a named type, such
+// as `type ID string`. The built-in floats are excluded as Compare uses a tolerance for them.
+func scalarKind[E any]() bool {
+	typ := reflect.TypeFor[E]()
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String, reflect.Complex64, reflect.Complex128,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Float32, reflect.Float64:
+		return typ.PkgPath() != ""
+	}
+	return false
+}
+
+// primitiveIndex is the reflection-free Index for the types whose == agrees with Compare.
+func primitiveIndex[E any](items []E, target E) (int, bool) {
+	switch s := any(items).(type) {
+	case []int:
+		return slices.Index(s, any(target).(int)), true
+	case []int8:
+		return slices.Index(s, any(target).(int8)), true
+	case []int16:
+		return slices.Index(s, any(target).(int16)), true
+	case []int32:
+		return slices.Index(s, any(target).(int32)), true
+	case []int64:
+		return slices.Index(s, any(target).(int64)), true
+	case []uint:
+		return slices.Index(s, any(target).(uint)), true
+	case []uint8:
+		return slices.Index(s, any(target).(uint8)), true
+	case []uint16:
+		return slices.Index(s, any(target).(uint16)), true
+	case []uint32:
+		return slices.Index(s, any(target).(uint32)), true
+	case []uint64:
+		return slices.Index(s, any(target).(uint64)), true
+	case []uintptr:
+		return slices.Index(s, any(target).(uintptr)), true
+	case []string:
+		return slices.Index(s, any(target).(string)), true
+	case []bool:
+		return slices.Index(s, any(target).(bool)), true
+	}
+
+	if scalarKind[E]() {
+		for i, item := range items {
+			if any(item) == any(target) {
+				return i, true
+			}
+		}
+		return -1, true
+	}
+	return -1, false
+}
+
+func primitiveFilter[E any](items, target []E, keep bool) ([]E, bool) {
+	var filtered any
+	switch s := any(items).(type) {
+	case []int:
+		filtered = filterBySet(s, any(target).([]int), keep)
+	case []int8:
+		filtered = filterBySet(s, any(target).([]int8), keep)
+	case []int16:
+		filtered = filterBySet(s, any(target).([]int16), keep)
+	case []int32:
+		filtered = filterBySet(s, any(target).([]int32), keep)
+	case []int64:
+		filtered = filterBySet(s, any(target).([]int64), keep)
+	case []uint:
+		filtered = filterBySet(s, any(target).([]uint), keep)
+	case []uint8:
+		filtered = filterBySet(s, any(target).([]uint8), keep)
+	case []uint16:
+		filtered = filterBySet(s, any(target).([]uint16), keep)
+	case []uint32:
+		filtered = filterBySet(s, any(target).([]uint32), keep)
+	case []uint64:
+		filtered = filterBySet(s, any(target).([]uint64), keep)
+	case []uintptr:
+		filtered = filterBySet(s, any(target).([]uintptr), keep)
+	case []string:
+		filtered = filterBySet(s, any(target).([]string), keep)
+	case []bool:
+		filtered = filterBySet(s, any(target).([]bool), keep)
+	case []float32:
+		filtered = filterByTolerance(s, any(target).([]float32), keep)
+	case []float64:
+		filtered = filterByTolerance(s, any(target).([]float64), keep)
+	default:
+		if !scalarKind[E]() {
+			return nil, false
+		}
+
+		set := make(map[any]struct{}, len(target))
+		for _, item := range target {
+			set[any(item)] = struct{}{}
+		}
+
+		var result []E
+		for _, item := range items {
+			if _, ok := set[any(item)]; ok == keep {
+				result = append(result, item)
+			}
+		}
+		return result, true
+	}
+	return filtered.([]E), true
+}
+
+// filterByComparisonSet hashes the target once, floats held in interfaces and missing
+// from the set are looked up linearly since Compare treats them as equal within a tolerance.
+func filterByComparisonSet[T ~[]E, E any](items, target T, keep bool) T {
+	set := NewComparisonSet(true)
+	for _, item := range target {
+		set.Add(item)
+	}
+
+	var filtered T
+	for _, item := range items {
+		found := set.Has(item)
+		if !found && reflect.ValueOf(item).CanFloat() {
+			found = Index(target, item) != -1
+		}
+
+		if found == keep {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
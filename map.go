@@ -71,11 +71,119 @@ func (m *MapCollection[T, K, V]) Same(target T) bool {
 	return MapSame[T, K, V](m.z, target)
 }
 
+func (m *MapCollection[T, K, V]) Diff(target T) Changes {
+	return MapDiff[T, K, V](m.z, target)
+}
+
 func (m *MapCollection[T, K, V]) Merge(targets ...T) *MapCollection[T, K, V] {
 	m.z = MapMerge[T, K, V](m.z, targets...)
 	return m
 }
 
+func (m *MapCollection[T, K, V]) DeepMerge(targets ...T) *MapCollection[T, K, V] {
+	m.z = DeepMerge[T, K, V](m.z, targets...)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) DeepMergeWith(options MergeOptions, targets ...T) *MapCollection[T, K, V] {
+	m.z = DeepMergeWith[T, K, V](options, m.z, targets...)
+	return m
+}
+
 func (m *MapCollection[T, K, V]) Union(target T) *MapCollection[T, K, V] {
 	return m.New(Union[T, K, V](m.z, target))
 }
+
+func (m *MapCollection[T, K, V]) GetPath(path string) (any, bool) {
+	return GetPath[T, K, V](m.z, path)
+}
+
+func (m *MapCollection[T, K, V]) HasPath(path string) bool {
+	return HasPath[T, K, V](m.z, path)
+}
+
+func (m *MapCollection[T, K, V]) SetPath(path string, value any) *MapCollection[T, K, V] {
+	SetPath[T, K, V](m.z, path, value)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) ForgetPath(path string) *MapCollection[T, K, V] {
+	ForgetPath[T, K, V](m.z, path)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) Dot() *MapCollection[map[string]any, string, any] {
+	return UseMap(Dot[T, K, V](m.z))
+}
+
+func (m *MapCollection[T, K, V]) Undot() *MapCollection[map[string]any, string, any] {
+	return UseMap(Undot[T, K, V](m.z))
+}
+
+func (m *MapCollection[T, K, V]) Each(callback func(value V, key K)) *MapCollection[T, K, V] {
+	MapEach[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) Filter(callback func(value V, key K) bool) *MapCollection[T, K, V] {
+	m.z = MapFilter[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) FilterKeys(callback func(key K) bool) *MapCollection[T, K, V] {
+	m.z = MapFilterKeys[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) MapValues(callback func(value V, key K) V) *MapCollection[T, K, V] {
+	m.z = MapValues[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) MapKeys(callback func(value V, key K) K) *MapCollection[T, K, V] {
+	m.z = MapKeys[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) Reduce(initial V, callback func(carry V, value V, key K) V) V {
+	return MapReduce[T, K, V](m.z, initial, callback)
+}
+
+func (m *MapCollection[T, K, V]) Where(args ...any) *MapCollection[T, K, V] {
+	m.z = MapWhere[T, K, V](m.z, args...)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) Every(callback func(value V, key K) bool) bool {
+	return MapEvery[T, K, V](m.z, callback)
+}
+
+func (m *MapCollection[T, K, V]) Some(callback func(value V, key K) bool) bool {
+	return MapSome[T, K, V](m.z, callback)
+}
+
+func (m *MapCollection[T, K, V]) Partition(callback func(value V, key K) bool) (*MapCollection[T, K, V], *MapCollection[T, K, V]) {
+	passed, failed := MapPartition[T, K, V](m.z, callback)
+	return m.New(passed), m.New(failed)
+}
+
+func (m *MapCollection[T, K, V]) SortedKeys() []K {
+	return SortedKeys[T, K, V](m.z)
+}
+
+func (m *MapCollection[T, K, V]) SortedValues() []V {
+	return SortedValues[T, K, V](m.z)
+}
+
+func (m *MapCollection[T, K, V]) EachSorted(callback func(value V, key K)) *MapCollection[T, K, V] {
+	EachSorted[T, K, V](m.z, callback)
+	return m
+}
+
+func (m *MapCollection[T, K, V]) SortByKey() []Entry[K, V] {
+	return SortByKey[T, K, V](m.z)
+}
+
+func (m *MapCollection[T, K, V]) SortByValue() []Entry[K, V] {
+	return SortByValue[T, K, V](m.z)
+}
@@ -0,0 +1,196 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type SliceStrategy int
+
+const (
+	SliceReplace SliceStrategy = iota
+	SliceAppend
+	SliceAppendUnique
+	SliceMergeIndex
+)
+
+type MergeOptions struct {
+	Slices SliceStrategy
+	// Conflict resolves the values of different types at the same path, the target value wins when nil
+	Conflict func(path string, from, to any) any
+	// Mutate merges into the first map in place instead of a copy
+	Mutate bool
+}
+
+type merger struct {
+	options MergeOptions
+}
+
+func deepClone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		cloned := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			cloned.SetMapIndex(iter.Key(), deepClone(iter.Value()))
+		}
+		return cloned
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		cloned := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			cloned.Index(i).Set(deepClone(v.Index(i)))
+		}
+		return cloned
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		cloned := reflect.New(v.Type()).Elem()
+		cloned.Set(deepClone(v.Elem()))
+		return cloned
+	}
+	return v
+}
+
+func assignable(v any, typ reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(typ)
+	}
+
+	ref := reflect.ValueOf(v)
+	if !ref.Type().AssignableTo(typ) {
+		panic(fmt.Sprintf("cannot assign %T to %s", v, typ))
+	}
+	return ref
+}
+
+func (m *merger) merge(path string, from, to any) any {
+	if to == nil {
+		return nil
+	} else if from == nil {
+		return deepClone(reflect.ValueOf(to)).Interface()
+	}
+
+	a, b := reflect.ValueOf(from), reflect.ValueOf(to)
+	if a.Type() != b.Type() {
+		if m.options.Conflict != nil {
+			return m.options.Conflict(path, from, to)
+		}
+		return deepClone(b).Interface()
+	}
+
+	switch a.Kind() {
+	case reflect.Map:
+		merged := a
+		if !m.options.Mutate || a.IsNil() {
+			merged = reflect.MakeMapWithSize(a.Type(), a.Len())
+			iter := a.MapRange()
+			for iter.Next() {
+				merged.SetMapIndex(iter.Key(), iter.Value())
+			}
+		}
+
+		iter := b.MapRange()
+		for iter.Next() {
+			value := deepClone(iter.Value()).Interface()
+			if current := merged.MapIndex(iter.Key()); current.IsValid() {
+				value = m.merge(joinPath(path, iter.Key()), current.Interface(), iter.Value().Interface())
+			}
+			merged.SetMapIndex(iter.Key(), assignable(value, a.Type().Elem()))
+		}
+		return merged.Interface()
+	case reflect.Slice:
+		return m.mergeSlice(path, a, b).Interface()
+	}
+
+	return to
+}
+
+func (m *merger) mergeSlice(path string, a, b reflect.Value) reflect.Value {
+	switch m.options.Slices {
+	case SliceReplace:
+		return deepClone(b)
+	case SliceAppend, SliceAppendUnique:
+		merged := reflect.MakeSlice(a.Type(), 0, a.Len()+b.Len())
+		merged = reflect.AppendSlice(merged, a)
+
+		for i := 0; i < b.Len(); i++ {
+			item := b.Index(i)
+			if m.options.Slices == SliceAppendUnique && sliceContains(merged, item) {
+				continue
+			}
+			merged = reflect.Append(merged, deepClone(item))
+		}
+		return merged
+	case SliceMergeIndex:
+		length := a.Len()
+		if b.Len() > length {
+			length = b.Len()
+		}
+
+		merged := reflect.MakeSlice(a.Type(), length, length)
+		for i := 0; i < length; i++ {
+			switch true {
+			case i >= b.Len():
+				merged.Index(i).Set(a.Index(i))
+			case i >= a.Len():
+				merged.Index(i).Set(deepClone(b.Index(i)))
+			default:
+				value := m.merge(joinPath(path, i), a.Index(i).Interface(), b.Index(i).Interface())
+				merged.Index(i).Set(assignable(value, a.Type().Elem()))
+			}
+		}
+		return merged
+	}
+
+	panic("unknown slice strategy")
+}
+
+func sliceContains(items, item reflect.Value) bool {
+	for i := 0; i < items.Len(); i++ {
+		if Compare(items.Index(i).Interface(), "=", item.Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+func DeepMerge[T ~map[K]V, K comparable, V any](items T, targets ...T) T {
+	return DeepMergeWith[T, K, V](MergeOptions{}, items, targets...)
+}
+
+func DeepMergeWith[T ~map[K]V, K comparable, V any](options MergeOptions, items T, targets ...T) T {
+	merged := items
+	if !options.Mutate {
+		merged = deepClone(reflect.ValueOf(items)).Interface().(T)
+	}
+	if merged == nil {
+		merged = make(T)
+	}
+
+	m := &merger{options}
+	elem := reflect.TypeOf(merged).Elem()
+	for _, target := range targets {
+		for key, value := range target {
+			var result any = deepClone(reflect.ValueOf(&value).Elem()).Interface()
+			if current, ok := merged[key]; ok {
+				result = m.merge(fmt.Sprint(key), current, value)
+			}
+
+			var v V
+			reflect.ValueOf(&v).Elem().Set(assignable(result, elem))
+			merged[key] = v
+		}
+	}
+
+	return merged
+}
@@ -2,6 +2,7 @@ package collect
 
 import (
 	"golang.org/x/exp/constraints"
+	"math/big"
 )
 
 type NumberCollection[T ~[]E, E constraints.Integer | constraints.Float] struct {
@@ -41,3 +42,114 @@ func (n *NumberCollection[T, E]) Avg() float64 {
 func (n *NumberCollection[T, E]) Median() float64 {
 	return Median[T, E](n.All())
 }
+
+func (n *NumberCollection[T, E]) Histogram(bins any) ([]int, []float64) {
+	return Histogram[T, E](n.All(), bins)
+}
+
+func (n *NumberCollection[T, E]) Cut(bins any) []int {
+	return Cut[T, E](n.All(), bins)
+}
+
+func (n *NumberCollection[T, E]) QCut(q int) []int {
+	return QCut[T, E](n.All(), q)
+}
+
+func (n *NumberCollection[T, E]) CumSum() *NumberCollection[T, E] {
+	return UseNumber[T, E](CumSum[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) CumProd() *NumberCollection[T, E] {
+	return UseNumber[T, E](CumProd[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) CumMax() *NumberCollection[T, E] {
+	return UseNumber[T, E](CumMax[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) CumMin() *NumberCollection[T, E] {
+	return UseNumber[T, E](CumMin[T, E](n.All()))
+}
+
+// Delta is not called Diff, which would shadow the Diff(target) promoted from SliceCollection.
+func (n *NumberCollection[T, E]) Delta(lag int) *NumberCollection[[]float64, float64] {
+	return UseNumber[[]float64, float64](Delta[T, E](n.All(), lag))
+}
+
+func (n *NumberCollection[T, E]) PctChange() *NumberCollection[[]float64, float64] {
+	return UseNumber[[]float64, float64](PctChange[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) Normalize() *NumberCollection[[]float64, float64] {
+	return UseNumber[[]float64, float64](Normalize[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) Standardize() *NumberCollection[[]float64, float64] {
+	return UseNumber[[]float64, float64](Standardize[T, E](n.All()))
+}
+
+func (n *NumberCollection[T, E]) Clamp(lower, upper E) *NumberCollection[T, E] {
+	return UseNumber[T, E](Clamp[T, E](n.All(), lower, upper))
+}
+
+func (n *NumberCollection[T, E]) SumChecked() (E, error) {
+	return SumChecked[T, E](n.All())
+}
+
+type BigNumberCollection[T ~[]E, E any] struct {
+	*SliceCollection[T, E]
+	numeric Numeric[E]
+}
+
+func UseBigNumber[T ~[]E, E any](items T, numeric Numeric[E]) *BigNumberCollection[T, E] {
+	return &BigNumberCollection[T, E]{UseSlice[T, E](items), numeric}
+}
+
+func UseBigInt[T ~[]*big.Int](items T) *BigNumberCollection[T, *big.Int] {
+	return UseBigNumber[T, *big.Int](items, BigIntNumeric{})
+}
+
+func UseBigRat[T ~[]*big.Rat](items T) *BigNumberCollection[T, *big.Rat] {
+	return UseBigNumber[T, *big.Rat](items, BigRatNumeric{})
+}
+
+func UseDecimal[T ~[]Decimal](items T) *BigNumberCollection[T, Decimal] {
+	return UseBigNumber[T, Decimal](items, DecimalNumeric{})
+}
+
+func (b *BigNumberCollection[T, E]) Sum() E {
+	return BigSum[T, E](b.All(), b.numeric)
+}
+
+func (b *BigNumberCollection[T, E]) Min() E {
+	return BigMin[T, E](b.All(), b.numeric)
+}
+
+func (b *BigNumberCollection[T, E]) Max() E {
+	return BigMax[T, E](b.All(), b.numeric)
+}
+
+func (b *BigNumberCollection[T, E]) Sort() *BigNumberCollection[T, E] {
+	b.z = BigSort[T, E](b.All(), b.numeric)
+	return b
+}
+
+func (b *BigNumberCollection[T, E]) SortDesc() *BigNumberCollection[T, E] {
+	b.z = BigSortDesc[T, E](b.All(), b.numeric)
+	return b
+}
+
+func (b *BigNumberCollection[T, E]) Avg() *big.Rat {
+	return BigAvg[T, E](b.All(), b.numeric)
+}
+
+func (b *BigNumberCollection[T, E]) Median() *big.Rat {
+	return BigMedian[T, E](b.All(), b.numeric)
+}
+
+func (b *BigNumberCollection[T, E]) Where(operator string, target E) *BigNumberCollection[T, E] {
+	b.z = Filter(b.All(), func(value E, _ int) bool {
+		return BigCompare(b.numeric, value, operator, target)
+	})
+	return b
+}
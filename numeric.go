@@ -0,0 +1,176 @@
+package collect
+
+import (
+	"math/big"
+	"sort"
+)
+
+type Numeric[E any] interface {
+	Zero() E
+	Add(a, b E) E
+	Cmp(a, b E) int
+	Rat(a E) *big.Rat
+}
+
+type BigIntNumeric struct{}
+
+func (BigIntNumeric) value(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+func (BigIntNumeric) Zero() *big.Int {
+	return new(big.Int)
+}
+
+func (n BigIntNumeric) Add(a, b *big.Int) *big.Int {
+	return new(big.Int).Add(n.value(a), n.value(b))
+}
+
+func (n BigIntNumeric) Cmp(a, b *big.Int) int {
+	return n.value(a).Cmp(n.value(b))
+}
+
+func (n BigIntNumeric) Rat(a *big.Int) *big.Rat {
+	return new(big.Rat).SetInt(n.value(a))
+}
+
+type BigRatNumeric struct{}
+
+func (BigRatNumeric) value(v *big.Rat) *big.Rat {
+	if v == nil {
+		return new(big.Rat)
+	}
+	return v
+}
+
+func (BigRatNumeric) Zero() *big.Rat {
+	return new(big.Rat)
+}
+
+func (n BigRatNumeric) Add(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Add(n.value(a), n.value(b))
+}
+
+func (n BigRatNumeric) Cmp(a, b *big.Rat) int {
+	return n.value(a).Cmp(n.value(b))
+}
+
+func (n BigRatNumeric) Rat(a *big.Rat) *big.Rat {
+	return new(big.Rat).Set(n.value(a))
+}
+
+type DecimalNumeric struct{}
+
+func (DecimalNumeric) Zero() Decimal {
+	return Decimal{}
+}
+
+func (DecimalNumeric) Add(a, b Decimal) Decimal {
+	return a.Add(b)
+}
+
+func (DecimalNumeric) Cmp(a, b Decimal) int {
+	return a.Cmp(b)
+}
+
+func (DecimalNumeric) Rat(a Decimal) *big.Rat {
+	return a.Rat()
+}
+
+func BigCompare[E any](numeric Numeric[E], a E, operator string, b E) bool {
+	switch c := numeric.Cmp(a, b); operator {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func BigSum[T ~[]E, E any](items T, numeric Numeric[E]) E {
+	total := numeric.Zero()
+	for _, value := range items {
+		total = numeric.Add(total, value)
+	}
+	return total
+}
+
+func BigMin[T ~[]E, E any](items T, numeric Numeric[E]) E {
+	if len(items) == 0 {
+		return numeric.Zero()
+	}
+
+	min := items[0]
+	for _, value := range items {
+		if numeric.Cmp(min, value) > 0 {
+			min = value
+		}
+	}
+	return min
+}
+
+func BigMax[T ~[]E, E any](items T, numeric Numeric[E]) E {
+	if len(items) == 0 {
+		return numeric.Zero()
+	}
+
+	max := items[0]
+	for _, value := range items {
+		if numeric.Cmp(max, value) < 0 {
+			max = value
+		}
+	}
+	return max
+}
+
+func BigSort[T ~[]E, E any](items T, numeric Numeric[E]) T {
+	sort.SliceStable(items, func(i, j int) bool {
+		return numeric.Cmp(items[i], items[j]) < 0
+	})
+	return items
+}
+
+func BigSortDesc[T ~[]E, E any](items T, numeric Numeric[E]) T {
+	sort.SliceStable(items, func(i, j int) bool {
+		return numeric.Cmp(items[i], items[j]) > 0
+	})
+	return items
+}
+
+func BigAvg[T ~[]E, E any](items T, numeric Numeric[E]) *big.Rat {
+	if len(items) == 0 {
+		return new(big.Rat)
+	}
+
+	sum := numeric.Rat(BigSum[T, E](items, numeric))
+	return sum.Quo(sum, big.NewRat(int64(len(items)), 1))
+}
+
+func BigMedian[T ~[]E, E any](items T, numeric Numeric[E]) *big.Rat {
+	if len(items) == 0 {
+		return new(big.Rat)
+	}
+
+	replica := make(T, len(items))
+	copy(replica, items)
+	BigSort[T, E](replica, numeric)
+
+	half := len(replica) / 2
+	if len(replica)%2 != 0 {
+		return numeric.Rat(replica[half])
+	}
+
+	median := new(big.Rat).Add(numeric.Rat(replica[half-1]), numeric.Rat(replica[half]))
+	return median.Quo(median, big.NewRat(2, 1))
+}
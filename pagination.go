@@ -0,0 +1,161 @@
+package collect
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type Page[T ~[]E, E any] struct {
+	Items       T
+	Total       int
+	PerPage     int
+	CurrentPage int
+	LastPage    int
+	From        int
+	To          int
+	HasNext     bool
+}
+
+type CursorPage[T ~[]E, E any] struct {
+	Items      T
+	NextCursor string
+	HasNext    bool
+}
+
+func ForPage[T ~[]E, E any](items T, page, perPage int) T {
+	if perPage < 1 {
+		panic("perPage must be positive")
+	} else if page < 1 {
+		page = 1
+	}
+
+	if page-1 > math.MaxInt/perPage {
+		return items[len(items):]
+	}
+	return Slice[T, E](items, (page-1)*perPage, perPage)
+}
+
+func Paginate[T ~[]E, E any](items T, page, perPage int) *Page[T, E] {
+	if page < 1 {
+		page = 1
+	}
+
+	p := &Page[T, E]{
+		Items:       ForPage[T, E](items, page, perPage),
+		Total:       len(items),
+		PerPage:     perPage,
+		CurrentPage: page,
+		LastPage:    (len(items) + perPage - 1) / perPage,
+	}
+
+	if p.LastPage < 1 {
+		p.LastPage = 1
+	}
+	if len(p.Items) > 0 {
+		p.From = (page-1)*perPage + 1
+		p.To = p.From + len(p.Items) - 1
+	}
+
+	p.HasNext = page < p.LastPage
+	return p
+}
+
+func EncodeCursor(value any) (string, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor accepts both the plain key values created by EncodeCursor and the
+// [key, index] pairs returned in NextCursor, the index breaks ties between duplicate keys.
+func decodeCursor(cursor string, typ reflect.Type) (any, int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	index := math.MaxInt
+	if len(b) > 0 && b[0] == '[' {
+		var pair []json.RawMessage
+		if err := json.Unmarshal(b, &pair); err != nil || len(pair) != 2 {
+			return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidCursor)
+		} else if err := json.Unmarshal(pair[1], &index); err != nil {
+			return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+		b = pair[0]
+	}
+
+	v := reflect.New(typ)
+	if err := json.Unmarshal(b, v.Interface()); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return v.Elem().Interface(), index, nil
+}
+
+func CursorPaginate[T ~[]E, E any](items T, key any, cursor string, limit int) (*CursorPage[T, E], error) {
+	if limit < 1 {
+		return nil, errors.New("limit must be positive")
+	}
+
+	type candidate struct {
+		item  E
+		value any
+		index int
+	}
+
+	var candidates []candidate
+	for i, item := range items {
+		if v, err := AnyGet[any](item, key); err == nil && v != nil {
+			candidates = append(candidates, candidate{item, v, i})
+		}
+	}
+
+	var err error
+	var after any
+	var afterIndex int
+	if cursor != "" && len(candidates) > 0 {
+		if after, afterIndex, err = decodeCursor(cursor, reflect.TypeOf(candidates[0].value)); err != nil {
+			return nil, err
+		}
+	}
+
+	sortable := true
+	compare := func(a, b any) int {
+		c, ok := compareOrder(a, b)
+		sortable = sortable && ok
+		return c
+	}
+
+	if after != nil {
+		candidates = Filter(candidates, func(c candidate, _ int) bool {
+			n := compare(after, c.value)
+			return n < 0 || n == 0 && afterIndex < c.index
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return compare(candidates[i].value, candidates[j].value) < 0 })
+	if !sortable {
+		return nil, fmt.Errorf("key %v is not sortable", key)
+	}
+
+	page := &CursorPage[T, E]{Items: make(T, 0, min(limit, len(candidates))), HasNext: len(candidates) > limit}
+	for i := 0; i < len(candidates) && i < limit; i++ {
+		page.Items = append(page.Items, candidates[i].item)
+	}
+
+	if page.HasNext {
+		last := candidates[limit-1]
+		if page.NextCursor, err = EncodeCursor([]any{last.value, last.index}); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
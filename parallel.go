@@ -0,0 +1,171 @@
+package collect
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type ParallelOptions struct {
+	// Context cancels the remaining chunks once it is done
+	Context context.Context
+	// Workers defaults to GOMAXPROCS
+	Workers int
+	// ChunkSize is the number of consecutive elements a worker takes at a time
+	ChunkSize int
+}
+
+type PanicError struct {
+	Index int
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic at index %d: %v", e.Index, e.Value)
+}
+
+func parallelOptions(n int, options []ParallelOptions) ParallelOptions {
+	var o ParallelOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+
+	if o.Context == nil {
+		o.Context = context.Background()
+	}
+	if o.Workers < 1 {
+		o.Workers = runtime.GOMAXPROCS(0)
+	}
+	if o.ChunkSize < 1 {
+		o.ChunkSize = n / (o.Workers * 4)
+		if o.ChunkSize < 1 {
+			o.ChunkSize = 1
+		}
+	}
+	if chunks := (n + o.ChunkSize - 1) / o.ChunkSize; o.Workers > chunks {
+		o.Workers = chunks
+	}
+	return o
+}
+
+func runChunk(start, end int, callback func(index int)) (err error) {
+	index := start
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{index, r}
+		}
+	}()
+
+	for ; index < end; index++ {
+		callback(index)
+	}
+	return nil
+}
+
+// parallel calls the callback for each index in [0, n), the indexes of a chunk are visited in order by the same worker.
+func parallel(n int, o ParallelOptions, callback func(index int)) error {
+	var next atomic.Int64
+	var failed atomic.Bool
+	var once sync.Once
+	var first error
+
+	fail := func(err error) {
+		once.Do(func() { first = err })
+		failed.Store(true)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < o.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !failed.Load() {
+				if err := o.Context.Err(); err != nil {
+					fail(err)
+					return
+				}
+
+				start := int(next.Add(int64(o.ChunkSize))) - o.ChunkSize
+				if start >= n {
+					return
+				}
+
+				end := start + o.ChunkSize
+				if end > n {
+					end = n
+				}
+				if err := runChunk(start, end, callback); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return first
+}
+
+func ParallelEach[T ~[]E, E any](items T, callback func(value E, index int), options ...ParallelOptions) error {
+	return parallel(len(items), parallelOptions(len(items), options), func(index int) {
+		callback(items[index], index)
+	})
+}
+
+func ParallelMap[T ~[]E, E any](items T, callback func(value E, index int) E, options ...ParallelOptions) (T, error) {
+	mapped := make(T, len(items), cap(items))
+	err := parallel(len(items), parallelOptions(len(items), options), func(index int) {
+		mapped[index] = callback(items[index], index)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return mapped, nil
+}
+
+func ParallelFilter[T ~[]E, E any](items T, callback func(value E, index int) bool, options ...ParallelOptions) (T, error) {
+	keep := make([]bool, len(items))
+	err := parallel(len(items), parallelOptions(len(items), options), func(index int) {
+		keep[index] = callback(items[index], index)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered T
+	for index, item := range items {
+		if keep[index] {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// ParallelReduce reduces every chunk separately and then folds the partial results in order,
+// so the combine function must be associative.
+func ParallelReduce[T ~[]E, E any](items T, initial E, combine func(a, b E) E, options ...ParallelOptions) (E, error) {
+	o := parallelOptions(len(items), options)
+	partials := make([]E, (len(items)+o.ChunkSize-1)/o.ChunkSize)
+
+	err := parallel(len(items), o, func(index int) {
+		if chunk := index / o.ChunkSize; index%o.ChunkSize == 0 {
+			partials[chunk] = items[index]
+		} else {
+			partials[chunk] = combine(partials[chunk], items[index])
+		}
+	})
+
+	if err != nil {
+		var zero E
+		return zero, err
+	}
+
+	for _, partial := range partials {
+		initial = combine(initial, partial)
+	}
+	return initial, nil
+}
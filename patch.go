@@ -0,0 +1,252 @@
+package collect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var ErrPatchMismatch = errors.New("patch does not match")
+
+type EditOp int
+
+const (
+	EditEqual EditOp = iota
+	EditInsert
+	EditDelete
+)
+
+type Edit[E any] struct {
+	Op       EditOp
+	OldIndex int
+	NewIndex int
+	Items    []E
+}
+
+type Patch[E any] []Edit[E]
+
+type FieldChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+type KeyedChange[K comparable, I any] struct {
+	Key    K
+	From   I
+	To     I
+	Fields []FieldChange
+}
+
+type KeyedDiff[K comparable, I any] struct {
+	Added   []I
+	Removed []I
+	Changed []KeyedChange[K, I]
+}
+
+func Edits[T ~[]E, E any](from, to T) Patch[E] {
+	return EditsFunc(from, to, func(a, b E) bool {
+		return Compare(a, "=", b)
+	})
+}
+
+func EditsFunc[T ~[]E, E any](from, to T, equal func(a, b E) bool) Patch[E] {
+	n, m := len(from), len(to)
+	offset := n + m
+	v := make([]int, 2*offset+2)
+
+	// Myers' algorithm, each snapshot keeps the diagonals [-d, d] before step d
+	var trace [][]int
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && equal(from[x], to[y]) {
+				x, y = x+1, y+1
+			}
+
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var ops []EditOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		snapshot, k := trace[d], x-y
+
+		prevK := k - 1
+		if k == -d || (k != d && snapshot[k-1+d] < snapshot[k+1+d]) {
+			prevK = k + 1
+		}
+
+		prevX := 0
+		if d > 0 {
+			prevX = snapshot[prevK+d]
+		}
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, EditEqual)
+			x, y = x-1, y-1
+		}
+
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, EditInsert)
+			} else {
+				ops = append(ops, EditDelete)
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	var patch Patch[E]
+	x, y = 0, 0
+	for i := len(ops) - 1; i >= 0; i-- {
+		op := ops[i]
+		if len(patch) == 0 || patch[len(patch)-1].Op != op {
+			patch = append(patch, Edit[E]{Op: op, OldIndex: x, NewIndex: y})
+		}
+
+		last := &patch[len(patch)-1]
+		switch op {
+		case EditEqual:
+			last.Items = append(last.Items, from[x])
+			x, y = x+1, y+1
+		case EditInsert:
+			last.Items = append(last.Items, to[y])
+			y++
+		case EditDelete:
+			last.Items = append(last.Items, from[x])
+			x++
+		}
+	}
+	return patch
+}
+
+func ApplyPatch[T ~[]E, E any](items T, patch Patch[E]) (T, error) {
+	var result T
+	var pos int
+
+	for _, edit := range patch {
+		if edit.OldIndex != pos {
+			return nil, fmt.Errorf("%w: expected index %d, got %d", ErrPatchMismatch, pos, edit.OldIndex)
+		}
+
+		if edit.Op == EditInsert {
+			result = append(result, edit.Items...)
+			continue
+		}
+
+		if pos+len(edit.Items) > len(items) || !Same(items[pos:pos+len(edit.Items)], T(edit.Items)) {
+			return nil, fmt.Errorf("%w: items at index %d differ", ErrPatchMismatch, pos)
+		}
+
+		if edit.Op == EditEqual {
+			result = append(result, items[pos:pos+len(edit.Items)]...)
+		}
+		pos += len(edit.Items)
+	}
+
+	if pos != len(items) {
+		return nil, fmt.Errorf("%w: %d items left", ErrPatchMismatch, len(items)-pos)
+	}
+	return result, nil
+}
+
+func fieldChanges(from, to any) []FieldChange {
+	a, b := reflect.ValueOf(from), reflect.ValueOf(to)
+	for a.Kind() == reflect.Pointer && b.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() {
+		a, b = a.Elem(), b.Elem()
+	}
+
+	var changes []FieldChange
+	switch true {
+	case a.Kind() == reflect.Struct && a.Type() == b.Type():
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				continue
+			}
+
+			af, bf := a.Field(i).Interface(), b.Field(i).Interface()
+			if !reflect.DeepEqual(af, bf) {
+				changes = append(changes, FieldChange{a.Type().Field(i).Name, af, bf})
+			}
+		}
+	case a.Kind() == reflect.Map && a.Type() == b.Type():
+		keys := append(a.MapKeys(), b.MapKeys()...)
+		sort.SliceStable(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+
+		seen := NewComparisonSet(false)
+		for _, key := range keys {
+			if seen.Has(key.Interface()) {
+				continue
+			}
+			seen.Add(key.Interface())
+
+			var af, bf any
+			if v := a.MapIndex(key); v.IsValid() {
+				af = v.Interface()
+			}
+			if v := b.MapIndex(key); v.IsValid() {
+				bf = v.Interface()
+			}
+			if !reflect.DeepEqual(af, bf) {
+				changes = append(changes, FieldChange{fmt.Sprint(key), af, bf})
+			}
+		}
+	default:
+		if !reflect.DeepEqual(from, to) {
+			changes = append(changes, FieldChange{"", from, to})
+		}
+	}
+	return changes
+}
+
+func DiffBy[K comparable, I any](from, to []I, key any) KeyedDiff[K, I] {
+	var diff KeyedDiff[K, I]
+
+	index := make(map[K]I)
+	for _, item := range to {
+		if k, ok := keyOf[K](item, key); ok {
+			index[k] = item
+		}
+	}
+
+	seen := make(map[K]struct{})
+	for _, item := range from {
+		k, ok := keyOf[K](item, key)
+		if !ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		if target, ok := index[k]; !ok {
+			diff.Removed = append(diff.Removed, item)
+		} else if fields := fieldChanges(item, target); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, KeyedChange[K, I]{k, item, target, fields})
+		}
+	}
+
+	for _, item := range to {
+		if k, ok := keyOf[K](item, key); ok {
+			if _, ok := seen[k]; !ok {
+				diff.Added = append(diff.Added, item)
+				seen[k] = struct{}{}
+			}
+		}
+	}
+	return diff
+}
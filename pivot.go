@@ -0,0 +1,149 @@
+package collect
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+)
+
+type PivotTable[R, C comparable] struct {
+	Rows         []R
+	Columns      []C
+	Cells        map[R]map[C]any
+	RowTotals    map[R]any
+	ColumnTotals map[C]any
+	Total        any
+}
+
+func Pivot[R, C comparable, I any](items []I, rowKey, colKey any, aggregator Aggregator) *PivotTable[R, C] {
+	table := &PivotTable[R, C]{
+		Cells:        make(map[R]map[C]any),
+		RowTotals:    make(map[R]any),
+		ColumnTotals: make(map[C]any),
+	}
+
+	var all []I
+	rows := make(map[R][]I)
+	columns := make(map[C][]I)
+	cells := make(map[R]map[C][]I)
+	for _, item := range items {
+		r, ok1 := keyOf[R](item, rowKey)
+		c, ok2 := keyOf[C](item, colKey)
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		if _, ok := rows[r]; !ok {
+			table.Rows = append(table.Rows, r)
+			cells[r] = make(map[C][]I)
+		}
+		if _, ok := columns[c]; !ok {
+			table.Columns = append(table.Columns, c)
+		}
+
+		all = append(all, item)
+		rows[r] = append(rows[r], item)
+		columns[c] = append(columns[c], item)
+		cells[r][c] = append(cells[r][c], item)
+	}
+
+	for r, m := range cells {
+		table.Cells[r] = make(map[C]any, len(m))
+		for c, group := range m {
+			table.Cells[r][c] = aggregate(aggregator, group)
+		}
+		table.RowTotals[r] = aggregate(aggregator, rows[r])
+	}
+	for c, group := range columns {
+		table.ColumnTotals[c] = aggregate(aggregator, group)
+	}
+
+	table.Total = aggregate(aggregator, all)
+	return table
+}
+
+func (p *PivotTable[R, C]) Get(row R, column C) (any, bool) {
+	v, ok := p.Cells[row][column]
+	return v, ok
+}
+
+func (p *PivotTable[R, C]) SortRows(less func(a, b R) bool) *PivotTable[R, C] {
+	sort.SliceStable(p.Rows, func(i, j int) bool { return less(p.Rows[i], p.Rows[j]) })
+	return p
+}
+
+func (p *PivotTable[R, C]) SortColumns(less func(a, b C) bool) *PivotTable[R, C] {
+	sort.SliceStable(p.Columns, func(i, j int) bool { return less(p.Columns[i], p.Columns[j]) })
+	return p
+}
+
+func (p *PivotTable[R, C]) Maps(rowHeader string) []map[string]any {
+	if rowHeader == "Total" {
+		panic("row header collides with the Total column")
+	}
+
+	labels := map[string]struct{}{rowHeader: {}, "Total": {}}
+	for _, c := range p.Columns {
+		label := fmt.Sprint(c)
+		if _, ok := labels[label]; ok {
+			panic(fmt.Sprintf("column %q collides with another key of the row", label))
+		}
+		labels[label] = struct{}{}
+	}
+
+	result := make([]map[string]any, 0, len(p.Rows)+1)
+	for _, r := range p.Rows {
+		m := map[string]any{rowHeader: r, "Total": p.RowTotals[r]}
+		for _, c := range p.Columns {
+			m[fmt.Sprint(c)] = p.Cells[r][c]
+		}
+		result = append(result, m)
+	}
+
+	totals := map[string]any{rowHeader: "Total", "Total": p.Total}
+	for _, c := range p.Columns {
+		totals[fmt.Sprint(c)] = p.ColumnTotals[c]
+	}
+	return append(result, totals)
+}
+
+func (p *PivotTable[R, C]) WriteCSV(w io.Writer, rowHeader string) error {
+	cell := func(v any) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprint(v)
+	}
+
+	header := []string{rowHeader}
+	for _, c := range p.Columns {
+		header = append(header, fmt.Sprint(c))
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append(header, "Total")); err != nil {
+		return err
+	}
+
+	for _, r := range p.Rows {
+		record := []string{fmt.Sprint(r)}
+		for _, c := range p.Columns {
+			record = append(record, cell(p.Cells[r][c]))
+		}
+		if err := writer.Write(append(record, cell(p.RowTotals[r]))); err != nil {
+			return err
+		}
+	}
+
+	totals := []string{"Total"}
+	for _, c := range p.Columns {
+		totals = append(totals, cell(p.ColumnTotals[c]))
+	}
+	if err := writer.Write(append(totals, cell(p.Total))); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,192 @@
+package collect
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+type RandomSource interface {
+	Intn(n int) int
+	Float64() float64
+}
+
+type lockedSource struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (l *lockedSource) Intn(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Intn(n)
+}
+
+func (l *lockedSource) Float64() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Float64()
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+func (cryptoSource) Float64() float64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return float64(binary.LittleEndian.Uint64(b[:])>>11) / (1 << 53)
+}
+
+var CryptoRandomSource RandomSource = cryptoSource{}
+
+var defaultRandomSource = NewRandomSource(time.Now().UnixNano())
+
+func NewRandomSource(seed int64) RandomSource {
+	return &lockedSource{r: rand.New(rand.NewSource(seed))}
+}
+
+func randomSource(source []RandomSource) RandomSource {
+	if len(source) > 0 && source[0] != nil {
+		return source[0]
+	}
+	return defaultRandomSource
+}
+
+func Sample[T ~[]E, E any](items T, n int, source ...RandomSource) T {
+	src := randomSource(source)
+	if n > len(items) {
+		n = len(items)
+	} else if n < 0 {
+		n = 0
+	}
+
+	replica := make(T, len(items))
+	copy(replica, items)
+	for i := 0; i < n; i++ {
+		j := i + src.Intn(len(replica)-i)
+		replica[i], replica[j] = replica[j], replica[i]
+	}
+	return replica[:n]
+}
+
+func SampleWithReplacement[T ~[]E, E any](items T, n int, source ...RandomSource) T {
+	src := randomSource(source)
+	if len(items) == 0 || n < 0 {
+		n = 0
+	}
+
+	sampled := make(T, n)
+	for i := range sampled {
+		sampled[i] = items[src.Intn(len(items))]
+	}
+	return sampled
+}
+
+func WeightedRandom[T ~[]E, E any](items T, weight func(value E, index int) float64, source ...RandomSource) (E, bool) {
+	weights := make([]float64, len(items))
+
+	var total float64
+	for index, item := range items {
+		if w := weight(item, index); w > 0 {
+			weights[index] = w
+			total += w
+		}
+	}
+
+	var zero E
+	if total <= 0 {
+		return zero, false
+	}
+
+	r := randomSource(source).Float64() * total
+	for index, w := range weights {
+		if r -= w; w > 0 && r < 0 {
+			return items[index], true
+		}
+	}
+
+	for index := len(weights) - 1; index >= 0; index-- {
+		if weights[index] > 0 {
+			return items[index], true
+		}
+	}
+	return zero, false
+}
+
+func WeightedSample[T ~[]E, E any](items T, n int, weight func(value E, index int) float64, source ...RandomSource) T {
+	src := randomSource(source)
+
+	type keyed struct {
+		key   float64
+		index int
+	}
+
+	var keys []keyed
+	for index, item := range items {
+		if w := weight(item, index); w > 0 {
+			keys = append(keys, keyed{math.Log(src.Float64()) / w, index})
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].key > keys[j].key })
+	if n > len(keys) {
+		n = len(keys)
+	} else if n < 0 {
+		n = 0
+	}
+
+	sampled := make(T, n)
+	for i := range sampled {
+		sampled[i] = items[keys[i].index]
+	}
+	return sampled
+}
+
+type Reservoir[E any] struct {
+	size   int
+	seen   int
+	items  []E
+	source RandomSource
+}
+
+func NewReservoir[E any](size int, source ...RandomSource) *Reservoir[E] {
+	if size < 1 {
+		panic("reservoir size must be positive")
+	}
+	return &Reservoir[E]{size: size, items: make([]E, 0, size), source: randomSource(source)}
+}
+
+func (r *Reservoir[E]) Add(item E) {
+	r.seen++
+	if len(r.items) < r.size {
+		r.items = append(r.items, item)
+	} else if j := r.source.Intn(r.seen); j < r.size {
+		r.items[j] = item
+	}
+}
+
+func (r *Reservoir[E]) Items() []E {
+	return r.items
+}
+
+func (r *Reservoir[E]) Seen() int {
+	return r.seen
+}
@@ -1,15 +1,20 @@
 package collect
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
 )
 
 type SliceCollection[T ~[]E, E any] struct {
-	z T
+	z   T
+	err error
 }
 
 func UseSlice[T ~[]E, E any](items T) *SliceCollection[T, E] {
-	return &SliceCollection[T, E]{items}
+	return &SliceCollection[T, E]{z: items}
 }
 
 func (s *SliceCollection[T, E]) All() T {
@@ -17,7 +22,15 @@ func (s *SliceCollection[T, E]) All() T {
 }
 
 func (s *SliceCollection[T, E]) New(items T) *SliceCollection[T, E] {
-	return &SliceCollection[T, E]{items}
+	return &SliceCollection[T, E]{z: items, err: s.err}
+}
+
+func (s *SliceCollection[T, E]) Err() error {
+	return s.err
+}
+
+func (s *SliceCollection[T, E]) Result() (T, error) {
+	return s.z, s.err
 }
 
 func (s *SliceCollection[T, E]) Len() int {
@@ -63,6 +76,11 @@ func (s *SliceCollection[T, E]) Diff(target T) *SliceCollection[T, E] {
 	return s
 }
 
+func (s *SliceCollection[T, E]) Intersect(target T) *SliceCollection[T, E] {
+	s.z = Intersect[T, E](s.z, target)
+	return s
+}
+
 func (s *SliceCollection[T, E]) Filter(callback func(value E, index int) bool) *SliceCollection[T, E] {
 	s.z = Filter(s.z, callback)
 	return s
@@ -87,8 +105,8 @@ func (s *SliceCollection[T, E]) Merge(targets ...T) *SliceCollection[T, E] {
 	return s
 }
 
-func (s *SliceCollection[T, E]) Random() (E, bool) {
-	return Random[T, E](s.z)
+func (s *SliceCollection[T, E]) Random(source ...RandomSource) (E, bool) {
+	return Random[T, E](s.z, source...)
 }
 
 func (s *SliceCollection[T, E]) Reverse() *SliceCollection[T, E] {
@@ -96,8 +114,8 @@ func (s *SliceCollection[T, E]) Reverse() *SliceCollection[T, E] {
 	return s
 }
 
-func (s *SliceCollection[T, E]) Shuffle() *SliceCollection[T, E] {
-	s.z = Shuffle[T, E](s.z)
+func (s *SliceCollection[T, E]) Shuffle(source ...RandomSource) *SliceCollection[T, E] {
+	s.z = Shuffle[T, E](s.z, source...)
 	return s
 }
 
@@ -141,3 +159,171 @@ func (s *SliceCollection[T, E]) WhereNotIn(args ...any) *SliceCollection[T, E] {
 	s.z = WhereNotIn[T, E](s.z, args...)
 	return s
 }
+
+func (s *SliceCollection[T, E]) BinBy(bins any, callback func(value E, index int) float64) []T {
+	return BinBy[T, E](s.z, bins, callback)
+}
+
+func (s *SliceCollection[T, E]) ForPage(page, perPage int) *SliceCollection[T, E] {
+	s.z = ForPage[T, E](s.z, page, perPage)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Paginate(page, perPage int) *Page[T, E] {
+	return Paginate[T, E](s.z, page, perPage)
+}
+
+func (s *SliceCollection[T, E]) CursorPaginate(key any, cursor string, limit int) (*CursorPage[T, E], error) {
+	return CursorPaginate[T, E](s.z, key, cursor, limit)
+}
+
+func (s *SliceCollection[T, E]) Sample(n int, source ...RandomSource) *SliceCollection[T, E] {
+	s.z = Sample[T, E](s.z, n, source...)
+	return s
+}
+
+func (s *SliceCollection[T, E]) SampleWithReplacement(n int, source ...RandomSource) *SliceCollection[T, E] {
+	s.z = SampleWithReplacement[T, E](s.z, n, source...)
+	return s
+}
+
+func (s *SliceCollection[T, E]) WeightedRandom(weight func(value E, index int) float64, source ...RandomSource) (E, bool) {
+	return WeightedRandom[T, E](s.z, weight, source...)
+}
+
+func (s *SliceCollection[T, E]) WeightedSample(n int, weight func(value E, index int) float64, source ...RandomSource) *SliceCollection[T, E] {
+	s.z = WeightedSample[T, E](s.z, n, weight, source...)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Edits(target T) Patch[E] {
+	return Edits[T, E](s.z, target)
+}
+
+func (s *SliceCollection[T, E]) Apply(patch Patch[E]) *SliceCollection[T, E] {
+	if z, err := ApplyPatch[T, E](s.z, patch); err != nil {
+		s.err = errors.Join(s.err, err)
+	} else {
+		s.z = z
+	}
+	return s
+}
+
+func (s *SliceCollection[T, E]) TryMap(callback func(value E, index int) (E, error), policy ...ErrorPolicy) *SliceCollection[T, E] {
+	z, err := TryMap[T, E](s.z, callback, policy...)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) TryFilter(callback func(value E, index int) (bool, error), policy ...ErrorPolicy) *SliceCollection[T, E] {
+	z, err := TryFilter[T, E](s.z, callback, policy...)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) TryEach(callback func(value E, index int) error, policy ...ErrorPolicy) *SliceCollection[T, E] {
+	s.err = errors.Join(s.err, TryEach[T, E](s.z, callback, policy...))
+	return s
+}
+
+func (s *SliceCollection[T, E]) TryReduce(initial E, callback func(carry E, value E, key int) (E, error), policy ...ErrorPolicy) (E, error) {
+	carry, err := TryReduce[T, E](s.z, initial, callback, policy...)
+	return carry, errors.Join(s.err, err)
+}
+
+func (s *SliceCollection[T, E]) EachCtx(ctx context.Context, callback func(value E, index int)) *SliceCollection[T, E] {
+	s.err = errors.Join(s.err, EachCtx[T, E](ctx, s.z, callback))
+	return s
+}
+
+func (s *SliceCollection[T, E]) MapCtx(ctx context.Context, callback func(value E, index int) E) *SliceCollection[T, E] {
+	z, err := MapCtx[T, E](ctx, s.z, callback)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) FilterCtx(ctx context.Context, callback func(value E, index int) bool) *SliceCollection[T, E] {
+	z, err := FilterCtx[T, E](ctx, s.z, callback)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) ReduceCtx(ctx context.Context, initial E, callback func(carry E, value E, key int) E) (E, error) {
+	carry, err := ReduceCtx[T, E](ctx, s.z, initial, callback)
+	return carry, errors.Join(s.err, err)
+}
+
+func (s *SliceCollection[T, E]) ParallelEach(callback func(value E, index int), options ...ParallelOptions) *SliceCollection[T, E] {
+	s.err = errors.Join(s.err, ParallelEach[T, E](s.z, callback, options...))
+	return s
+}
+
+func (s *SliceCollection[T, E]) ParallelMap(callback func(value E, index int) E, options ...ParallelOptions) *SliceCollection[T, E] {
+	z, err := ParallelMap[T, E](s.z, callback, options...)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) ParallelFilter(callback func(value E, index int) bool, options ...ParallelOptions) *SliceCollection[T, E] {
+	z, err := ParallelFilter[T, E](s.z, callback, options...)
+	s.z, s.err = z, errors.Join(s.err, err)
+	return s
+}
+
+func (s *SliceCollection[T, E]) ParallelReduce(initial E, combine func(a, b E) E, options ...ParallelOptions) (E, error) {
+	carry, err := ParallelReduce[T, E](s.z, initial, combine, options...)
+	return carry, errors.Join(s.err, err)
+}
+
+func (s *SliceCollection[T, E]) Stream() *Stream[E] {
+	items, index := s.z, 0
+	return NewStream(func() (E, error) {
+		if index >= len(items) {
+			var zero E
+			return zero, io.EOF
+		}
+
+		index++
+		return items[index-1], nil
+	})
+}
+
+func (s *SliceCollection[T, E]) BinarySearchFunc(target E, cmp func(a, b E) int) (int, bool) {
+	return slices.BinarySearchFunc(s.z, target, cmp)
+}
+
+func (s *SliceCollection[T, E]) Compact() *SliceCollection[T, E] {
+	s.z = slices.CompactFunc(s.z, func(a, b E) bool {
+		return Compare(a, "=", b)
+	})
+	return s
+}
+
+func (s *SliceCollection[T, E]) Insert(index int, values ...E) *SliceCollection[T, E] {
+	s.z = slices.Insert(s.z, index, values...)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Delete(i, j int) *SliceCollection[T, E] {
+	s.z = slices.Delete(s.z, i, j)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Replace(i, j int, values ...E) *SliceCollection[T, E] {
+	s.z = slices.Replace(s.z, i, j, values...)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Clip() *SliceCollection[T, E] {
+	s.z = slices.Clip(s.z)
+	return s
+}
+
+func (s *SliceCollection[T, E]) Grow(n int) *SliceCollection[T, E] {
+	s.z = slices.Grow(s.z, n)
+	return s
+}
+
+func (s *SliceCollection[T, E]) EqualFunc(target T, eq func(a, b E) bool) bool {
+	return slices.EqualFunc(s.z, target, eq)
+}
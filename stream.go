@@ -0,0 +1,135 @@
+package collect
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Stream is a lazy sequence, the elements are pulled one by one until the source returns io.EOF.
+type Stream[E any] struct {
+	next func() (E, error)
+	ctx  context.Context
+	done bool
+	err  error
+}
+
+func NewStream[E any](next func() (E, error)) *Stream[E] {
+	return &Stream[E]{next: next, ctx: context.Background()}
+}
+
+func (s *Stream[E]) WithContext(ctx context.Context) *Stream[E] {
+	s.ctx = ctx
+	return s
+}
+
+func (s *Stream[E]) pull() (E, error) {
+	if err := s.ctx.Err(); err != nil {
+		var zero E
+		return zero, err
+	}
+	return s.next()
+}
+
+func (s *Stream[E]) Next() (E, bool) {
+	var zero E
+	if s.done {
+		return zero, false
+	}
+
+	value, err := s.pull()
+	if err != nil {
+		s.done = true
+		if !errors.Is(err, io.EOF) {
+			s.err = err
+		}
+		return zero, false
+	}
+	return value, true
+}
+
+func (s *Stream[E]) Err() error {
+	return s.err
+}
+
+func (s *Stream[E]) Filter(callback func(value E, index int) bool) *Stream[E] {
+	next, index := s.next, -1
+	s.next = func() (E, error) {
+		for {
+			value, err := next()
+			if err != nil {
+				return value, err
+			}
+
+			if index++; callback(value, index) {
+				return value, nil
+			} else if err = s.ctx.Err(); err != nil {
+				return value, err
+			}
+		}
+	}
+	return s
+}
+
+func (s *Stream[E]) Map(callback func(value E, index int) E) *Stream[E] {
+	next, index := s.next, -1
+	s.next = func() (E, error) {
+		value, err := next()
+		if err != nil {
+			return value, err
+		}
+
+		index++
+		return callback(value, index), nil
+	}
+	return s
+}
+
+func (s *Stream[E]) Where(args ...any) *Stream[E] {
+	if len(args) < 1 {
+		return s
+	}
+
+	predicate := wherePredicate(args...)
+	return s.Filter(func(value E, _ int) bool {
+		return predicate(value)
+	})
+}
+
+func (s *Stream[E]) Take(n int) *Stream[E] {
+	next, taken := s.next, 0
+	s.next = func() (E, error) {
+		if taken >= n {
+			var zero E
+			return zero, io.EOF
+		}
+
+		taken++
+		return next()
+	}
+	return s
+}
+
+func (s *Stream[E]) Each(callback func(value E, index int)) error {
+	for index := 0; ; index++ {
+		value, ok := s.Next()
+		if !ok {
+			return s.err
+		}
+		callback(value, index)
+	}
+}
+
+func (s *Stream[E]) Count() (int, error) {
+	var count int
+	err := s.Each(func(E, int) { count++ })
+	return count, err
+}
+
+func (s *Stream[E]) Collect() *SliceCollection[[]E, E] {
+	var items []E
+	err := s.Each(func(value E, _ int) {
+		items = append(items, value)
+	})
+	return &SliceCollection[[]E, E]{z: items, err: err}
+}
@@ -0,0 +1,97 @@
+package collect
+
+import (
+	"errors"
+	"fmt"
+	"golang.org/x/exp/constraints"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+var ErrOverflow = errors.New("number overflow")
+
+func isFloat[E constraints.Integer | constraints.Float]() bool {
+	var zero E
+	kind := reflect.TypeOf(zero).Kind()
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// compensatedSum accumulates floats with the Neumaier variant of Kahan summation.
+type compensatedSum struct {
+	sum, c float64
+}
+
+func (s *compensatedSum) Add(v float64) {
+	t := s.sum + v
+	if math.Abs(s.sum) >= math.Abs(v) {
+		s.c += (s.sum - t) + v
+	} else {
+		s.c += (v - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s *compensatedSum) Result() float64 {
+	if math.IsInf(s.sum, 0) || math.IsNaN(s.sum) {
+		return s.sum
+	}
+	return s.sum + s.c
+}
+
+func SumChecked[T ~[]E, E constraints.Integer | constraints.Float](items T) (total E, _ error) {
+	if isFloat[E]() {
+		var s compensatedSum
+		for index, value := range items {
+			if math.IsInf(float64(value), 0) {
+				return Sum[T, E](items), nil
+			}
+
+			s.Add(float64(value))
+			if total = E(s.Result()); math.IsInf(float64(total), 0) {
+				return 0, fmt.Errorf("%w at index %d", ErrOverflow, index)
+			}
+		}
+		return
+	}
+
+	for index, value := range items {
+		r := total + value
+		if (value > 0 && r < total) || (value < 0 && r > total) {
+			return 0, fmt.Errorf("%w at index %d", ErrOverflow, index)
+		}
+		total = r
+	}
+	return
+}
+
+func SumWide[T ~[]E, E constraints.Integer](items T) *big.Int {
+	total := new(big.Int)
+
+	var zero E
+	if zero-1 < zero {
+		var acc int64
+		for _, value := range items {
+			v := int64(value)
+			if r := acc + v; (v > 0 && r < acc) || (v < 0 && r > acc) {
+				total.Add(total, big.NewInt(acc))
+				acc = v
+			} else {
+				acc = r
+			}
+		}
+		return total.Add(total, big.NewInt(acc))
+	}
+
+	var acc uint64
+	for _, value := range items {
+		v := uint64(value)
+		if r := acc + v; r < acc {
+			total.Add(total, new(big.Int).SetUint64(acc))
+			acc = v
+		} else {
+			acc = r
+		}
+	}
+	return total.Add(total, new(big.Int).SetUint64(acc))
+}
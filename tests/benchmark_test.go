@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"fmt"
+	. "github.com/sxyazi/go-collection"
+	"testing"
+)
+
+func benchmarkInts(n, offset int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i*2 + offset
+	}
+	return items
+}
+
+func BenchmarkDiff_Int(b *testing.B) {
+	d1, d2 := benchmarkInts(100000, 0), benchmarkInts(100000, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(d1, d2)
+	}
+}
+
+func BenchmarkDiff_String(b *testing.B) {
+	d1, d2 := Map(make([]string, 100000), func(_ string, i int) string { return fmt.Sprint(i) }), []string{"1", "2"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(d1, d2)
+	}
+}
+
+func BenchmarkDiff_Float(b *testing.B) {
+	d1 := Map(make([]float64, 100000), func(_ float64, i int) float64 { return float64(i) / 3 })
+	d2 := Map(make([]float64, 100000), func(_ float64, i int) float64 { return float64(i) / 7 })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(d1, d2)
+	}
+}
+
+func BenchmarkDiff_Named(b *testing.B) {
+	type ID string
+	d1 := Map(make([]ID, 100000), func(_ ID, i int) ID { return ID(fmt.Sprint(i)) })
+	d2 := Map(make([]ID, 100000), func(_ ID, i int) ID { return ID(fmt.Sprint(-i)) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(d1, d2)
+	}
+}
+
+func BenchmarkDiff_Struct(b *testing.B) {
+	d1 := Map(make([]Foo, 100000), func(_ Foo, i int) Foo { return Foo{Bar: fmt.Sprint(i)} })
+	d2 := Map(make([]Foo, 100000), func(_ Foo, i int) Foo { return Foo{Bar: fmt.Sprint(-i)} })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(d1, d2)
+	}
+}
+
+func BenchmarkIntersect_Int(b *testing.B) {
+	d1, d2 := benchmarkInts(100000, 0), benchmarkInts(100000, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Intersect(d1, d2)
+	}
+}
+
+func BenchmarkIndex_Int(b *testing.B) {
+	d := benchmarkInts(100000, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Index(d, -1)
+	}
+}
+
+func BenchmarkIndex_Struct(b *testing.B) {
+	d := Map(make([]Foo, 100000), func(_ Foo, i int) Foo { return Foo{Bar: fmt.Sprint(i)} })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Index(d, Foo{})
+	}
+}
+
+func BenchmarkContains_String(b *testing.B) {
+	d := Map(make([]string, 100000), func(_ string, i int) string { return fmt.Sprint(i) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Contains(d, "")
+	}
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestStream_UseChan(t *testing.T) {
+	ch := ToChannel(context.Background(), []int{1, 2, 3}, 0)
+	if !UseChan(ch).Same([]int{1, 2, 3}) {
+		t.Fail()
+	}
+
+	stream := FromChannel(ToChannel(context.Background(), []int{1, 2, 3, 4}, 2)).Filter(func(value int, index int) bool {
+		return value%2 == 0
+	})
+	if !stream.Collect().Same([]int{2, 4}) {
+		t.Fail()
+	}
+}
+
+func TestStream_FromChannelContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	ch := make(chan int)
+	if err := FromChannel(ch).WithContext(ctx).Each(func(int, int) {}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fail()
+	}
+}
+
+func TestStream_ToChannel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChannel(ctx, []int{1, 2, 3}, 0)
+
+	if v := <-ch; v != 1 {
+		t.Fail()
+	}
+	cancel()
+
+	// The sender gives up once the context is done
+	for range ch {
+	}
+}
+
+func TestStream_FanIn(t *testing.T) {
+	ctx := context.Background()
+	merged := UseChan(FanIn(ctx, ToChannel(ctx, []int{1, 2}, 0), ToChannel(ctx, []int{3, 4, 5}, 1))).All()
+
+	sort.Ints(merged)
+	if !Same(merged, []int{1, 2, 3, 4, 5}) {
+		t.Fail()
+	}
+}
+
+func TestStream_FanOut(t *testing.T) {
+	ctx := context.Background()
+	channels := FanOut(ctx, ToChannel(ctx, []int{1, 2, 3, 4, 5, 6}, 0), 3)
+	if len(channels) != 3 {
+		t.Fail()
+	}
+
+	merged := UseChan(FanIn(ctx, channels...)).All()
+	sort.Ints(merged)
+	if !Same(merged, []int{1, 2, 3, 4, 5, 6}) {
+		t.Fail()
+	}
+}
+
+func TestStream_FanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	src := make(chan int)
+	merged, channels := FanIn(ctx, src), FanOut(ctx, src, 2)
+	cancel()
+
+	for _, ch := range append(channels, merged) {
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Fail()
+			}
+		case <-time.After(time.Second):
+			t.Fatal("the output was not closed after cancel")
+		}
+	}
+}
+
+func TestStream_Batch(t *testing.T) {
+	ctx := context.Background()
+	batches := UseChan(Batch(ctx, ToChannel(ctx, []int{1, 2, 3, 4, 5}, 0), 2, 0)).All()
+	if len(batches) != 3 || !Same(batches[0], []int{1, 2}) || !Same(batches[1], []int{3, 4}) || !Same(batches[2], []int{5}) {
+		t.Fail()
+	}
+
+	ch := make(chan int)
+	out := Batch(ctx, ch, 10, 20*time.Millisecond)
+	go func() {
+		ch <- 1
+		ch <- 2
+	}()
+
+	select {
+	case batch := <-out:
+		if !Same(batch, []int{1, 2}) {
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout did not flush the batch")
+	}
+	close(ch)
+
+	if _, ok := <-out; ok {
+		t.Fail()
+	}
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"testing"
+)
+
+var (
+	_ Collection[[]int, int]                                 = UseSlice([]int{})
+	_ Collection[[]int, int]                                 = UseNumber([]int{})
+	_ Collection[map[string]int, int]                        = UseMap(map[string]int{})
+	_ Printable[*SliceCollection[[]int, int]]                = UseSlice([]int{})
+	_ Printable[*SliceCollection[[]int, int]]                = UseNumber([]int{})
+	_ Printable[*MapCollection[map[string]int, string, int]] = UseMap(map[string]int{})
+	_ Iterable[int]                                          = NewStream[int](nil)
+)
+
+func total[T any](c Collection[T, int]) (sum int) {
+	for value := range c.Values() {
+		sum += value
+	}
+	return
+}
+
+func TestCollection(t *testing.T) {
+	if total[[]int](UseSlice([]int{1, 2})) != 3 || total[[]int](UseNumber([]int{3})) != 3 || total[map[string]int](UseMap(map[string]int{"a": 4})) != 4 {
+		t.Fail()
+	}
+
+	sized := []Sized{UseSlice([]int{}), UseNumber([]int{1}), UseMap(map[int]int{1: 1, 2: 2})}
+	if !sized[0].Empty() || sized[1].Len() != 1 || sized[2].Len() != 2 {
+		t.Fail()
+	}
+}
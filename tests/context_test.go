@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"testing"
+)
+
+func TestSlice_EachCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var visited []int
+	err := EachCtx(ctx, []int{1, 2, 3, 4}, func(value int, index int) {
+		visited = append(visited, value)
+		if value == 2 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) || !Same(visited, []int{1, 2}) {
+		t.Fail()
+	}
+
+	if err := EachCtx(context.Background(), []int{1, 2}, func(value int, index int) {}); err != nil {
+		t.Fail()
+	}
+}
+
+func TestSlice_MapCtx(t *testing.T) {
+	if mapped, err := MapCtx(context.Background(), []int{1, 2}, func(value int, index int) int {
+		return value * 2
+	}); err != nil || !Same(mapped, []int{2, 4}) {
+		t.Fail()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if mapped, err := MapCtx(ctx, []int{1, 2}, func(value int, index int) int {
+		return value
+	}); mapped != nil || !errors.Is(err, context.Canceled) {
+		t.Fail()
+	}
+}
+
+func TestSlice_FilterCtx(t *testing.T) {
+	if filtered, err := FilterCtx(context.Background(), []int{1, 2, 3, 4}, func(value int, index int) bool {
+		return value%2 == 0
+	}); err != nil || !Same(filtered, []int{2, 4}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_ReduceCtx(t *testing.T) {
+	sum := func(carry int, value int, key int) int {
+		return carry + value
+	}
+	if total, err := ReduceCtx(context.Background(), []int{1, 2, 3}, 0, sum); err != nil || total != 6 {
+		t.Fail()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if total, err := ReduceCtx(ctx, []int{1, 2, 3}, 0, sum); total != 0 || err == nil {
+		t.Fail()
+	}
+}
+
+func TestSlice_Ctx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	result, err := UseSlice([]int{1, 2, 3, 4}).MapCtx(ctx, func(value int, index int) int {
+		return value * 10
+	}).FilterCtx(ctx, func(value int, index int) bool {
+		return value > 10
+	}).Result()
+	if err != nil || !Same(result, []int{20, 30, 40}) {
+		t.Fail()
+	}
+
+	c := UseSlice([]int{1, 2, 3}).EachCtx(ctx, func(value int, index int) {
+		cancel()
+	})
+	if !errors.Is(c.Err(), context.Canceled) {
+		t.Fail()
+	}
+	if _, err := c.ReduceCtx(context.Background(), 0, func(carry int, value int, key int) int {
+		return carry + value
+	}); !errors.Is(err, context.Canceled) {
+		t.Fail()
+	}
+}
@@ -26,3 +26,25 @@ func TestNumberFrom(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestMapFrom(t *testing.T) {
+	users := UseSlice([]User{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}})
+
+	if !MapFrom[uint](users, "ID").Same(map[uint]User{1: {ID: 1, Name: "foo"}, 2: {ID: 2, Name: "bar"}}) {
+		t.Fail()
+	}
+	if !MapFrom[string](users, func(item User) string { return item.Name }).Same(map[string]User{"foo": {ID: 1, Name: "foo"}, "bar": {ID: 2, Name: "bar"}}) {
+		t.Fail()
+	}
+}
+
+func TestSliceFrom(t *testing.T) {
+	m := UseMap(map[string]int{"b": 2, "a": 1, "c": 3})
+
+	if !SliceFrom(m).Same([]int{1, 2, 3}) {
+		t.Fail()
+	}
+	if !PairsFrom(m).Same([]Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "c", Value: 3}}) {
+		t.Fail()
+	}
+}
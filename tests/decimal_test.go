@@ -0,0 +1,92 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"math/big"
+	"testing"
+)
+
+func TestDecimal_Parse(t *testing.T) {
+	for s, expected := range map[string]string{
+		"0":       "0",
+		"12.50":   "12.50",
+		"-0.005":  "-0.005",
+		"+3.1":    "3.1",
+		".5":      "0.5",
+		"7.":      "7",
+		"-12345":  "-12345",
+		"0000.10": "0.10",
+	} {
+		if d, err := ParseDecimal(s); err != nil || d.String() != expected {
+			t.Error(s, d, err)
+		}
+	}
+
+	for _, s := range []string{"", "-", ".", "1.2.3", "1e5", "+-1", "abc"} {
+		if _, err := ParseDecimal(s); err == nil {
+			t.Error(s)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a, _ := ParseDecimal("10.25")
+	b, _ := ParseDecimal("0.5")
+
+	if a.Add(b).String() != "10.75" || a.Sub(b).String() != "9.75" || b.Sub(a).String() != "-9.75" {
+		t.Fail()
+	}
+	if a.Mul(b).String() != "5.125" {
+		t.Fail()
+	}
+	if a.Quo(NewDecimal(3, 0), 2, RoundHalfUp).String() != "3.42" {
+		t.Fail()
+	}
+	if a.Neg().String() != "-10.25" || (Decimal{}).String() != "0" {
+		t.Fail()
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || NewDecimal(50, 2).Cmp(b) != 0 {
+		t.Fail()
+	}
+	if a.Float64() != 10.25 || a.Rat().Cmp(big.NewRat(41, 4)) != 0 {
+		t.Fail()
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	cases := []struct {
+		value    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.5", RoundHalfUp, "3"},
+		{"-2.5", RoundHalfUp, "-3"},
+		{"2.5", RoundHalfEven, "2"},
+		{"3.5", RoundHalfEven, "4"},
+		{"2.5", RoundHalfDown, "2"},
+		{"2.51", RoundHalfDown, "3"},
+		{"2.1", RoundUp, "3"},
+		{"-2.1", RoundUp, "-3"},
+		{"2.9", RoundDown, "2"},
+		{"-2.9", RoundDown, "-2"},
+		{"-2.1", RoundCeiling, "-2"},
+		{"2.1", RoundCeiling, "3"},
+		{"2.9", RoundFloor, "2"},
+		{"-2.1", RoundFloor, "-3"},
+	}
+
+	for _, c := range cases {
+		d, _ := ParseDecimal(c.value)
+		if r := d.Round(0, c.mode); r.String() != c.expected {
+			t.Error(c.value, c.mode, r)
+		}
+	}
+
+	d, _ := ParseDecimal("1.5")
+	if d.Round(3, RoundDown).String() != "1.500" {
+		t.Fail()
+	}
+	if DecimalFromRat(big.NewRat(2, 3), 4, RoundHalfEven).String() != "0.6667" {
+		t.Fail()
+	}
+}
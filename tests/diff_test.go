@@ -0,0 +1,110 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"testing"
+	"time"
+)
+
+type diffServer struct {
+	Host  string
+	Ports []int
+	Tags  map[string]string
+}
+
+func TestMap_Diff(t *testing.T) {
+	from := map[string]any{
+		"name": "app",
+		"db":   map[string]any{"host": "localhost", "port": 5432},
+		"servers": []diffServer{
+			{Host: "a", Ports: []int{80, 443}},
+			{Host: "b"},
+		},
+		"debug": true,
+	}
+	to := map[string]any{
+		"name": "app",
+		"db":   map[string]any{"host": "db.local", "port": 5432, "user": "root"},
+		"servers": []diffServer{
+			{Host: "a", Ports: []int{80}, Tags: map[string]string{"env": "prod"}},
+		},
+		"timeout": 30,
+	}
+
+	changes := MapDiff(from, to)
+	expected := Changes{
+		{Kind: ChangeModified, Path: "db.host", From: "localhost", To: "db.local"},
+		{Kind: ChangeAdded, Path: "db.user", To: "root"},
+		{Kind: ChangeRemoved, Path: "debug", From: true},
+		{Kind: ChangeRemoved, Path: "servers.0.Ports.1", From: 443},
+		{Kind: ChangeAdded, Path: "servers.0.Tags.env", To: "prod"},
+		{Kind: ChangeRemoved, Path: "servers.1", From: diffServer{Host: "b"}},
+		{Kind: ChangeAdded, Path: "timeout", To: 30},
+	}
+	if len(changes) != len(expected) {
+		t.Fatal(changes)
+	}
+	for i, change := range changes {
+		if change.Kind != expected[i].Kind || change.Path != expected[i].Path {
+			t.Error(change)
+		}
+	}
+	if changes[0].From != "localhost" || changes[0].To != "db.local" {
+		t.Fail()
+	}
+
+	if len(UseMap(from).Diff(from)) != 0 {
+		t.Fail()
+	}
+}
+
+func TestMap_DiffLeaves(t *testing.T) {
+	now := time.Now()
+	from := map[string]any{"f": 0.1 + 0.2, "t": now, "n": nil, "i": 1, "p": &Foo{Bar: "a"}}
+	to := map[string]any{"f": 0.3, "t": now.UTC(), "n": nil, "i": "1", "p": &Foo{Bar: "b"}}
+
+	changes := MapDiff(from, to)
+	if len(changes) != 2 || changes[0].Path != "i" || changes[1].Path != "p.Bar" {
+		t.Error(changes)
+	}
+
+	if changes := MapDiff(map[int]any{1: nil}, map[int]any{1: 2}); len(changes) != 1 || changes[0].Kind != ChangeModified {
+		t.Fail()
+	}
+
+	// Numbers of different kinds are compared by value, e.g. JSON-decoded floats against int literals
+	if changes := MapDiff(map[string]any{"a": 1.0, "b": 2}, map[string]any{"a": 1, "b": 2.5}); changes.String() != "~ b: 2 -> 2.5\n" {
+		t.Error(changes.String())
+	}
+}
+
+func TestMap_DeepDiff(t *testing.T) {
+	if changes := DeepDiff(1, 2); len(changes) != 1 || changes[0].Path != "" {
+		t.Fail()
+	}
+	if changes := DeepDiff([]int{1, 2}, []int{1, 3, 4}); changes.String() != "~ 1: 2 -> 3\n+ 2: 4\n" {
+		t.Error(changes.String())
+	}
+	if changes := DeepDiff(User{ID: 1}, User{ID: 1, Name: "foo"}); changes.String() != "~ Name:  -> foo\n" {
+		t.Error(changes.String())
+	}
+	if changes := DeepDiff("a", nil); changes.String() != "~ (root): a -> <nil>\n" {
+		t.Error(changes.String())
+	}
+
+	type node struct {
+		Value int
+		Next  *node
+	}
+	a, b := &node{Value: 1}, &node{Value: 1}
+	a.Next, b.Next = a, b
+	if len(DeepDiff(a, b)) != 0 {
+		t.Fail()
+	}
+
+	m1, m2 := map[string]any{"v": 1}, map[string]any{"v": 2}
+	m1["self"], m2["self"] = m1, m2
+	if changes := DeepDiff(m1, m2); changes.String() != "~ v: 1 -> 2\n" {
+		t.Error(changes.String())
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"reflect"
+	"testing"
+)
+
+func dotConfig() map[string]any {
+	return map[string]any{
+		"db": map[string]any{
+			"primary": map[string]any{"host": "localhost", "port": 5432},
+			"replicas": []any{
+				map[string]any{"host": "r1"},
+			},
+		},
+		"debug": true,
+		"empty": map[string]any{},
+	}
+}
+
+func TestMap_GetPath(t *testing.T) {
+	cfg := dotConfig()
+
+	if v, ok := GetPath(cfg, "db.primary.host"); !ok || v != "localhost" {
+		t.Fail()
+	}
+	if v, ok := UseMap(cfg).GetPath("db.replicas.0.host"); !ok || v != "r1" {
+		t.Fail()
+	}
+	if _, ok := GetPath(cfg, "db.primary.user"); ok {
+		t.Fail()
+	}
+	if _, ok := GetPath(cfg, "db.replicas.1.host"); ok {
+		t.Fail()
+	}
+	if _, ok := GetPath(cfg, "debug.value"); ok {
+		t.Fail()
+	}
+
+	if !HasPath(cfg, "db.primary") || HasPath(cfg, "db.secondary") {
+		t.Fail()
+	}
+
+	numbered := map[int]map[uint]string{1: {2: "foo"}}
+	if v, ok := GetPath(numbered, "1.2"); !ok || v != "foo" {
+		t.Fail()
+	}
+	if HasPath(numbered, "a.2") {
+		t.Fail()
+	}
+}
+
+func TestMap_SetPath(t *testing.T) {
+	cfg := dotConfig()
+
+	UseMap(cfg).SetPath("db.primary.port", 5433).SetPath("cache.redis.host", "127.0.0.1").SetPath("db.replicas.0.port", 6543)
+	if v, _ := GetPath(cfg, "db.primary.port"); v != 5433 {
+		t.Fail()
+	}
+	if v, _ := GetPath(cfg, "cache.redis.host"); v != "127.0.0.1" {
+		t.Fail()
+	}
+	if v, _ := GetPath(cfg, "db.replicas.0.port"); v != 6543 {
+		t.Fail()
+	}
+
+	// Scalars in the way are replaced
+	SetPath(cfg, "debug.level", 2)
+	if v, _ := GetPath(cfg, "debug"); !reflect.DeepEqual(v, map[string]any{"level": 2}) {
+		t.Fail()
+	}
+
+	typed := map[string]map[string]int{}
+	SetPath(typed, "a.b", 1)
+	if typed["a"]["b"] != 1 {
+		t.Fail()
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+	SetPath(typed, "a.b.c", 1)
+}
+
+func TestMap_ForgetPath(t *testing.T) {
+	cfg := dotConfig()
+
+	UseMap(cfg).ForgetPath("db.primary.port").ForgetPath("missing.key").ForgetPath("debug")
+	if HasPath(cfg, "db.primary.port") || !HasPath(cfg, "db.primary.host") || HasPath(cfg, "debug") {
+		t.Fail()
+	}
+}
+
+func TestMap_Dot(t *testing.T) {
+	flat := UseMap(dotConfig()).Dot().All()
+	expected := map[string]any{
+		"db.primary.host": "localhost",
+		"db.primary.port": 5432,
+		"db.replicas":     []any{map[string]any{"host": "r1"}},
+		"debug":           true,
+		"empty":           map[string]any{},
+	}
+	if !reflect.DeepEqual(flat, expected) {
+		t.Error(flat)
+	}
+
+	if !reflect.DeepEqual(Undot(flat), dotConfig()) {
+		t.Fail()
+	}
+	if !reflect.DeepEqual(UseMap(map[string]int{"a": 1, "a.b": 2, "c.d": 3}).Undot().All(), map[string]any{"a": map[string]any{"b": 2}, "c": map[string]any{"d": 3}}) {
+		t.Fail()
+	}
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	. "github.com/sxyazi/go-collection"
+	"math"
 	"strconv"
 	"testing"
 )
@@ -78,3 +79,23 @@ func TestFunctional_SortByDesc(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestFunctional_SumWide(t *testing.T) {
+	if SumWide([]int8{100, 100, 100}).Int64() != 300 {
+		t.Fail()
+	}
+
+	d1 := []int64{math.MaxInt64, math.MaxInt64, -1}
+	if SumWide(d1).String() != "18446744073709551613" {
+		t.Fail()
+	}
+
+	d2 := []uint64{math.MaxUint64, 2}
+	if SumWide(d2).String() != "18446744073709551617" {
+		t.Fail()
+	}
+
+	if SumWide([]int{}).Sign() != 0 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"testing"
+)
+
+var groupedOrders = []Order{
+	{ID: 1, UserID: 2, Amount: 10},
+	{ID: 2, UserID: 1, Amount: 0.25},
+	{ID: 3, UserID: 2, Amount: 32.5},
+	{ID: 4, UserID: 1, Amount: 0.5},
+	{ID: 5, UserID: 3, Amount: 7},
+}
+
+func TestGrouped_UseGroupBy(t *testing.T) {
+	g := UseGroupBy[uint](groupedOrders, "UserID")
+	if g.Len() != 3 || g.Empty() || !UseSlice(g.Keys()).Same([]uint{2, 1, 3}) {
+		t.Fail()
+	}
+	if items, ok := g.Get(1); !ok || !UseSlice(items).Same([]Order{groupedOrders[1], groupedOrders[3]}) {
+		t.Fail()
+	}
+	if _, ok := g.Get(4); ok {
+		t.Fail()
+	}
+
+	if !UseGroupBy[uint](groupedOrders, "Missing").Empty() {
+		t.Fail()
+	}
+}
+
+func TestGrouped_UseGroupByMissing(t *testing.T) {
+	d := []map[string]string{{"Role": "admin"}, {"Name": "Lucy"}, {"Role": "guest"}, {"Role": "admin"}}
+
+	g := UseGroupByMissing(d, "Role", "(none)")
+	if !UseSlice(g.Keys()).Same([]string{"admin", "(none)", "guest"}) {
+		t.Fail()
+	}
+	if items, _ := g.Get("(none)"); len(items) != 1 || items[0]["Name"] != "Lucy" {
+		t.Fail()
+	}
+
+	if rows := UseGroupByMissing(d, "Missing", "(none)").Aggregate(AggCount()); len(rows) != 1 || rows[0].Values["count"] != 4 {
+		t.Fail()
+	}
+}
+
+func TestGrouped_Each(t *testing.T) {
+	var keys []uint
+	var sizes []int
+	UseGroupBy[uint](groupedOrders, "UserID").Each(func(items []Order, key uint) {
+		keys = append(keys, key)
+		sizes = append(sizes, len(items))
+	})
+
+	if !UseSlice(keys).Same([]uint{2, 1, 3}) || !UseSlice(sizes).Same([]int{2, 2, 1}) {
+		t.Fail()
+	}
+}
+
+func TestGrouped_Aggregate(t *testing.T) {
+	rows := UseGroupBy[uint](groupedOrders, "UserID").Aggregate(
+		AggCount(),
+		AggSum("Amount"),
+		AggAvg("Amount").As("average"),
+		AggMin("Amount"),
+		AggMax("Amount"),
+		AggFirst("ID"),
+		AggLast(nil),
+		AggFunc("ids", func(items []Order) any {
+			return Pluck[uint](items, "ID")
+		}),
+	)
+
+	if len(rows) != 3 || rows[0].Key != 2 || rows[1].Key != 1 || rows[2].Key != 3 {
+		t.FailNow()
+	}
+
+	v := rows[1].Values
+	if v["count"] != 2 || v["sum(Amount)"] != 0.75 || v["average"] != 0.375 {
+		t.Fail()
+	}
+	if v["min(Amount)"] != 0.25 || v["max(Amount)"] != 0.5 || v["first(ID)"] != uint(2) {
+		t.Fail()
+	}
+	if v["last"] != groupedOrders[3] || !UseSlice(v["ids"].([]uint)).Same([]uint{2, 4}) {
+		t.Fail()
+	}
+
+	if len(UseGroupBy[uint](groupedOrders, "UserID").Aggregate()[0].Values) != 0 {
+		t.Fail()
+	}
+
+	if len(UseGroupBy[uint](groupedOrders, "UserID").Aggregate(AggMin("Amount"), AggMin("Amount").As("lowest"))) != 3 {
+		t.Fail()
+	}
+
+	defer func() {
+		if recover() != `duplicate aggregator name "min(Amount)", use As to rename it` {
+			t.Fail()
+		}
+	}()
+	UseGroupBy[uint](groupedOrders, "UserID").Aggregate(AggMin("Amount"), AggMin("Amount"))
+}
+
+func TestGrouped_Having(t *testing.T) {
+	g := UseGroupBy[uint](groupedOrders, "UserID").Having(AggSum("Amount"), ">", 5.0)
+	if !UseSlice(g.Keys()).Same([]uint{2, 3}) || g.Len() != 2 {
+		t.Fail()
+	}
+
+	rows := g.Having(AggCount(), ">=", 2).Aggregate(AggSum("Amount"))
+	if len(rows) != 1 || rows[0].Key != 2 || rows[0].Values["sum(Amount)"] != 42.5 {
+		t.Fail()
+	}
+
+	if !UseSlice(UseGroupBy[uint](groupedOrders, "UserID").Having(AggSum("Amount"), ">", 5).Keys()).Same([]uint{2, 3}) {
+		t.Fail()
+	}
+	if !UseSlice(UseGroupBy[uint](groupedOrders, "UserID").Having(AggSum("Amount"), "=", 7).Keys()).Same([]uint{3}) {
+		t.Fail()
+	}
+	if !UseSlice(UseGroupBy[uint](groupedOrders, "UserID").Having(AggCount(), ">", uint(1)).Keys()).Same([]uint{2, 1}) {
+		t.Fail()
+	}
+	if !UseGroupBy[uint](groupedOrders, "UserID").Having(AggCount(), ">", 5).Empty() {
+		t.Fail()
+	}
+}
+
+func TestGrouped_UseGroupByFunc(t *testing.T) {
+	g := UseGroupByFunc(groupedOrders, func(value Order, _ int) bool {
+		return value.Amount >= 10
+	})
+	if !UseSlice(g.Keys()).Same([]bool{true, false}) {
+		t.Fail()
+	}
+
+	rows := g.Aggregate(AggCount())
+	if rows[0].Values["count"] != 2 || rows[1].Values["count"] != 3 {
+		t.Fail()
+	}
+}
@@ -134,6 +134,70 @@ func TestHelpers_GroupBy(t *testing.T) {
 	}
 }
 
+func TestHelpers_GroupByFunc(t *testing.T) {
+	users := []User{{ID: 33, Name: "Lucy"}, {ID: 193, Name: "Peter"}, {ID: 194, Name: "Lacie"}}
+	r := GroupByFunc(users, func(value User, _ int) string {
+		return value.Name[:1]
+	})
+	if len(r) != 2 || len(r["L"]) != 2 || len(r["P"]) != 1 {
+		t.Fail()
+	}
+	if r["L"][0].ID != 33 || r["L"][1].ID != 194 {
+		t.Fail()
+	}
+}
+
+func TestHelpers_GroupByKeys(t *testing.T) {
+	m := []map[string]any{
+		{"Region": "EU", "Month": 1, "Amount": 10},
+		{"Region": "EU", "Month": 2, "Amount": 20},
+		{"Region": "US", "Month": 1, "Amount": 30},
+		{"Region": "EU", "Month": 1, "Amount": 40},
+		{"Region": "US", "Amount": 50},
+		{"Region": "US", "Month": []int{1}, "Amount": 60},
+	}
+
+	r := GroupByKeys(m, "Region", "Month")
+	if len(r) != 3 || len(r[[2]any{"EU", 1}]) != 2 || len(r[[2]any{"EU", 2}]) != 1 || len(r[[2]any{"US", 1}]) != 1 {
+		t.Fail()
+	}
+	if r[[2]any{"EU", 1}][1]["Amount"] != 40 {
+		t.Fail()
+	}
+
+	// Items missing any of the keys are dropped, unless a bucket is given
+	if n := len(r[[2]any{"EU", 1}]) + len(r[[2]any{"EU", 2}]) + len(r[[2]any{"US", 1}]); n != 4 {
+		t.Fail()
+	}
+
+	r = GroupByKeysMissing(m, "(none)", "Region", "Month")
+	if len(r) != 4 || len(r[[2]any{"US", "(none)"}]) != 2 || len(r[[2]any{"EU", 1}]) != 2 {
+		t.Fail()
+	}
+	if r[[2]any{"US", "(none)"}][0]["Amount"] != 50 || r[[2]any{"US", "(none)"}][1]["Amount"] != 60 {
+		t.Fail()
+	}
+	if r := GroupByKeysMissing(m, nil, "Missing"); len(r) != 1 || len(r[[1]any{nil}]) != len(m) {
+		t.Fail()
+	}
+
+	users := []User{{ID: 33, Name: "Lucy"}, {ID: 193, Name: "Peter"}}
+	if r := GroupByKeys(users, "Name"); len(r) != 2 || r[[1]any{"Peter"}][0].ID != 193 {
+		t.Fail()
+	}
+}
+
+func TestHelpers_GroupByMissing(t *testing.T) {
+	m := []map[string]string{{"Role": "admin"}, {"Name": "Lucy"}, {"Role": "guest"}, {"Role": "admin"}}
+	r := GroupByMissing(m, "Role", "(none)")
+	if len(r) != 3 || len(r["admin"]) != 2 || len(r["guest"]) != 1 || len(r["(none)"]) != 1 {
+		t.Fail()
+	}
+	if r["(none)"][0]["Name"] != "Lucy" {
+		t.Fail()
+	}
+}
+
 func TestHelpers_MapGroupBy(t *testing.T) {
 	m := []map[string]int{{"ID": 33, "Age": 40}, {"ID": 193, "Age": 25}, {"ID": 194, "Age": 25}}
 	r := MapGroupBy(m, "Age")
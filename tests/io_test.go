@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestStream_FromLines(t *testing.T) {
+	r := strings.NewReader("INFO start\r\nERROR disk full\n\nINFO " + strings.Repeat("x", 100000) + "\nERROR timeout")
+
+	if !FromLines(r).Where("!=", "").Filter(func(value string, index int) bool {
+		return strings.HasPrefix(value, "ERROR")
+	}).Collect().Same([]string{"ERROR disk full", "ERROR timeout"}) {
+		t.Fail()
+	}
+
+	if count, err := FromLines(strings.NewReader("a\nb\n")).Count(); err != nil || count != 2 {
+		t.Fail()
+	}
+	if count, err := FromLines(strings.NewReader("")).Count(); err != nil || count != 0 {
+		t.Fail()
+	}
+}
+
+func TestStream_FromJSONLines(t *testing.T) {
+	r := strings.NewReader(`{"ID": 1, "Name": "foo"}` + "\n" + `{"ID": 2, "Name": "bar"}` + "\n")
+
+	users := FromJSONLines[User](r).Where("ID", ">", uint(1)).Collect().All()
+	if len(users) != 1 || users[0].Name != "bar" {
+		t.Fail()
+	}
+
+	c := FromJSONLines[User](strings.NewReader(`{"ID": 1}` + "\n" + `{"ID": "x"}`)).Collect()
+	if c.Len() != 1 || c.Err() == nil || !strings.HasPrefix(c.Err().Error(), "record 1: ") {
+		t.Fail()
+	}
+}
+
+func TestStream_ChunkStream(t *testing.T) {
+	chunks := ChunkStream(FromLines(strings.NewReader("1\n2\n3\n4\n5")), 2).Collect().All()
+	if len(chunks) != 3 || !Same(chunks[0], []string{"1", "2"}) || !Same(chunks[2], []string{"5"}) {
+		t.Fail()
+	}
+
+	errBroken := errors.New("broken")
+	n := 0
+	broken := NewStream(func() (int, error) {
+		if n++; n > 3 {
+			return 0, errBroken
+		}
+		return n, nil
+	})
+	if c := ChunkStream(broken, 2).Collect(); c.Len() != 2 || !Same(c.All()[1], []int{3}) || !errors.Is(c.Err(), errBroken) {
+		t.Fail()
+	}
+
+	r := io.MultiReader(strings.NewReader("1\n2\n3\n"), iotest.ErrReader(errBroken))
+	stream := ChunkStream(FromLines(r), 2)
+	if chunk, ok := stream.Next(); !ok || !Same(chunk, []string{"1", "2"}) {
+		t.FailNow()
+	}
+	if chunk, ok := stream.Next(); !ok || !Same(chunk, []string{"3"}) || stream.Err() != nil {
+		t.FailNow()
+	}
+	if _, ok := stream.Next(); ok || !errors.Is(stream.Err(), errBroken) {
+		t.Fail()
+	}
+}
+
+func TestStream_WriteLines(t *testing.T) {
+	var buf bytes.Buffer
+	if n, err := WriteLines(&buf, UseSlice([]int{1, 2, 3}).Stream()); err != nil || n != 3 || buf.String() != "1\n2\n3\n" {
+		t.Fail()
+	}
+
+	if _, err := WriteLines(failingWriter{}, UseSlice([]int{1}).Stream()); !errors.Is(err, io.ErrClosedPipe) {
+		t.Fail()
+	}
+}
+
+func TestStream_WriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	users := UseSlice([]User{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}}).Stream()
+	if n, err := WriteJSONLines(&buf, users); err != nil || n != 2 {
+		t.Fail()
+	}
+
+	if !FromJSONLines[User](&buf).Collect().Same([]User{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}}) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestSlice_Values(t *testing.T) {
+	var values []int
+	for value := range UseSlice([]int{1, 2, 3, 4}).Values() {
+		if value == 3 {
+			break
+		}
+		values = append(values, value)
+	}
+	if !Same(values, []int{1, 2}) {
+		t.Fail()
+	}
+
+	var indexes []int
+	for index, value := range UseSlice([]string{"a", "b"}).Pairs() {
+		if value != []string{"a", "b"}[index] {
+			t.Fail()
+		}
+		indexes = append(indexes, index)
+	}
+	if !Same(indexes, []int{0, 1}) {
+		t.Fail()
+	}
+
+	if !Same(slices.Collect(UseSlice([]int{1, 2}).Values()), []int{1, 2}) {
+		t.Fail()
+	}
+}
+
+func TestMap_Values(t *testing.T) {
+	d := map[string]int{"a": 1, "b": 2}
+
+	sum := 0
+	for value := range UseMap(d).Values() {
+		sum += value
+	}
+	if sum != 3 {
+		t.Fail()
+	}
+
+	if !UseMap(maps.Collect(UseMap(d).Pairs())).Same(d) {
+		t.Fail()
+	}
+}
+
+func TestStream_Values(t *testing.T) {
+	var values []int
+	for value := range counter(10).Values() {
+		if value > 3 {
+			break
+		}
+		values = append(values, value)
+	}
+	if !Same(values, []int{1, 2, 3}) {
+		t.Fail()
+	}
+
+	s := counter(3)
+	for index, value := range s.Pairs() {
+		if value != index+1 {
+			t.Fail()
+		}
+	}
+	if s.Err() != nil {
+		t.Fail()
+	}
+}
+
+func TestStream_FromSeq(t *testing.T) {
+	if !FromSeq(slices.Values([]int{3, 1, 2})).Same([]int{3, 1, 2}) {
+		t.Fail()
+	}
+	if FromSeq(slices.Values([]int{})).Len() != 0 {
+		t.Fail()
+	}
+
+	d := map[string]int{"a": 1, "b": 2}
+	if !FromSeq2(maps.All(d)).Same(d) {
+		t.Fail()
+	}
+	if !FromSeq2(slices.All([]string{"a", "b"})).Same(map[int]string{0: "a", 1: "b"}) {
+		t.Fail()
+	}
+}
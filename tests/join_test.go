@@ -0,0 +1,106 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"testing"
+)
+
+var (
+	joinUsers  = []User{{ID: 1, Name: "Lucy"}, {ID: 2, Name: "Peter"}, {ID: 3, Name: "Mary"}}
+	joinOrders = []Order{{ID: 10, UserID: 2, Amount: 9.5}, {ID: 11, UserID: 1, Amount: 20}, {ID: 12, UserID: 2, Amount: 3}, {ID: 13, UserID: 9, Amount: 1}}
+)
+
+func joinIDs(pairs []JoinPair[Order, User]) [][2]uint {
+	return JoinSelect(pairs, func(order *Order, user *User) [2]uint {
+		var ids [2]uint
+		if order != nil {
+			ids[0] = order.ID
+		}
+		if user != nil {
+			ids[1] = user.ID
+		}
+		return ids
+	})
+}
+
+func TestJoin_Join(t *testing.T) {
+	pairs := Join[uint](joinOrders, joinUsers, "UserID", "ID")
+	if !UseSlice(joinIDs(pairs)).Same([][2]uint{{10, 2}, {11, 1}, {12, 2}}) {
+		t.Fail()
+	}
+	if pairs[0].Left != &joinOrders[0] || pairs[0].Right != &joinUsers[1] {
+		t.Fail()
+	}
+
+	callback := Join[uint](joinOrders, joinUsers, func(order Order) uint {
+		return order.UserID
+	}, func(user User) uint {
+		return user.ID
+	})
+	if !UseSlice(joinIDs(callback)).Same(joinIDs(pairs)) {
+		t.Fail()
+	}
+
+	if len(Join[uint](joinOrders, joinUsers, "Missing", "ID")) != 0 {
+		t.Fail()
+	}
+}
+
+func TestJoin_LeftJoin(t *testing.T) {
+	pairs := LeftJoin[uint](joinOrders, joinUsers, "UserID", "ID")
+	if !UseSlice(joinIDs(pairs)).Same([][2]uint{{10, 2}, {11, 1}, {12, 2}, {13, 0}}) {
+		t.Fail()
+	}
+	if pairs[3].Right != nil {
+		t.Fail()
+	}
+}
+
+func TestJoin_RightJoin(t *testing.T) {
+	pairs := RightJoin[uint](joinOrders, joinUsers, "UserID", "ID")
+	if !UseSlice(joinIDs(pairs)).Same([][2]uint{{11, 1}, {10, 2}, {12, 2}, {0, 3}}) {
+		t.Fail()
+	}
+	if pairs[3].Left != nil {
+		t.Fail()
+	}
+}
+
+func TestJoin_FullOuterJoin(t *testing.T) {
+	pairs := FullOuterJoin[uint](joinOrders, joinUsers, "UserID", "ID")
+	if !UseSlice(joinIDs(pairs)).Same([][2]uint{{10, 2}, {11, 1}, {12, 2}, {13, 0}, {0, 3}}) {
+		t.Fail()
+	}
+}
+
+func TestJoin_SemiJoin(t *testing.T) {
+	if !UseSlice(SemiJoin[uint](joinUsers, joinOrders, "ID", "UserID")).Same(joinUsers[:2]) {
+		t.Fail()
+	}
+
+	d := []map[string]uint{{"id": 1}, {"id": 5}}
+	if !UseSlice(SemiJoin[uint](d, joinUsers, "id", "ID")).Same(d[:1]) {
+		t.Fail()
+	}
+}
+
+func TestJoin_AntiJoin(t *testing.T) {
+	if !UseSlice(AntiJoin[uint](joinUsers, joinOrders, "ID", "UserID")).Same(joinUsers[2:]) {
+		t.Fail()
+	}
+	if !UseSlice(AntiJoin[uint](joinOrders, joinUsers, "UserID", "ID")).Same(joinOrders[3:]) {
+		t.Fail()
+	}
+}
+
+func TestJoin_InvalidCallback(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "key callback must be of type func(tests.Order) uint, got func(tests.Order) int" {
+			t.Error(r)
+		}
+	}()
+
+	Join[uint](joinOrders, joinUsers, func(order Order) int {
+		return int(order.UserID)
+	}, "ID")
+}
@@ -257,3 +257,148 @@ func TestMap_Union(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestMap_Each(t *testing.T) {
+	sum := 0
+	UseMap(map[string]int{"a": 1, "b": 2}).Each(func(value int, key string) {
+		sum += value
+	})
+	if sum != 3 {
+		t.Fail()
+	}
+}
+
+func TestMap_Filter(t *testing.T) {
+	d := map[string]int{"a": 1, "b": 2, "c": 3}
+	if !UseMap(d).Filter(func(value int, key string) bool {
+		return value%2 == 1
+	}).Same(map[string]int{"a": 1, "c": 3}) {
+		t.Fail()
+	}
+	if !UseMap(d).FilterKeys(func(key string) bool {
+		return key != "a"
+	}).Same(map[string]int{"b": 2, "c": 3}) {
+		t.Fail()
+	}
+	if len(d) != 3 {
+		t.Fail()
+	}
+}
+
+func TestMap_MapValues(t *testing.T) {
+	d := map[string]int{"a": 1, "b": 2}
+	if !UseMap(d).MapValues(func(value int, key string) int {
+		return value * 10
+	}).Same(map[string]int{"a": 10, "b": 20}) {
+		t.Fail()
+	}
+	if !UseMap(d).MapKeys(func(value int, key string) string {
+		return key + key
+	}).Same(map[string]int{"aa": 1, "bb": 2}) {
+		t.Fail()
+	}
+}
+
+func TestMap_Reduce(t *testing.T) {
+	d := map[string]int{"a": 1, "b": 2, "c": 3}
+	if UseMap(d).Reduce(10, func(carry int, value int, key string) int {
+		return carry + value
+	}) != 16 {
+		t.Fail()
+	}
+}
+
+func TestMap_Where(t *testing.T) {
+	d1 := map[string]int{"a": 1, "b": 2, "c": 3}
+	if !UseMap(d1).Where(2).Same(map[string]int{"b": 2}) {
+		t.Fail()
+	}
+	if !UseMap(d1).Where(">=", 2).Same(map[string]int{"b": 2, "c": 3}) {
+		t.Fail()
+	}
+
+	d2 := map[string]User{"lucy": {ID: 1, Name: "Lucy"}, "peter": {ID: 2, Name: "Peter"}}
+	if !UseMap(d2).Where("Name", "Peter").Same(map[string]User{"peter": {ID: 2, Name: "Peter"}}) {
+		t.Fail()
+	}
+	if !UseMap(d2).Where("ID", "<", uint(2)).Same(map[string]User{"lucy": {ID: 1, Name: "Lucy"}}) {
+		t.Fail()
+	}
+}
+
+func TestMap_EverySome(t *testing.T) {
+	d := map[string]int{"a": 1, "b": 2}
+	positive := func(value int, key string) bool { return value > 0 }
+	even := func(value int, key string) bool { return value%2 == 0 }
+
+	if !UseMap(d).Every(positive) || UseMap(d).Every(even) {
+		t.Fail()
+	}
+	if !UseMap(d).Some(even) || UseMap(d).Some(func(value int, key string) bool { return value > 2 }) {
+		t.Fail()
+	}
+	if !UseMap(map[string]int{}).Every(even) || UseMap(map[string]int{}).Some(positive) {
+		t.Fail()
+	}
+}
+
+func TestMap_Partition(t *testing.T) {
+	passed, failed := UseMap(map[string]int{"a": 1, "b": 2, "c": 3}).Partition(func(value int, key string) bool {
+		return value > 1
+	})
+	if !passed.Same(map[string]int{"b": 2, "c": 3}) || !failed.Same(map[string]int{"a": 1}) {
+		t.Fail()
+	}
+}
+
+func TestMap_FlipInvert(t *testing.T) {
+	if !UseMap(Flip(map[string]int{"a": 1, "b": 2})).Same(map[int]string{1: "a", 2: "b"}) {
+		t.Fail()
+	}
+
+	inverted := Invert(map[string]int{"a": 1, "b": 2, "c": 1})
+	if len(inverted) != 2 || len(inverted[1]) != 2 || !UseSlice(inverted[2]).Same([]string{"b"}) {
+		t.Fail()
+	}
+	if !Contains(inverted[1], "a") || !Contains(inverted[1], "c") {
+		t.Fail()
+	}
+}
+
+func TestMap_SortedKeys(t *testing.T) {
+	d := map[string]int{"b": 1, "c": 3, "a": 2}
+	if !UseSlice(UseMap(d).SortedKeys()).Same([]string{"a", "b", "c"}) {
+		t.Fail()
+	}
+	if !UseSlice(UseMap(d).SortedValues()).Same([]int{2, 1, 3}) {
+		t.Fail()
+	}
+	if !UseSlice(SortedKeys(map[int]bool{10: true, 2: true, -1: false})).Same([]int{-1, 2, 10}) {
+		t.Fail()
+	}
+	if !UseSlice(SortedKeys(map[Foo]int{{Bar: "b"}: 1, {Bar: "a"}: 2})).Same([]Foo{{Bar: "a"}, {Bar: "b"}}) {
+		t.Fail()
+	}
+
+	var keys []string
+	UseMap(d).EachSorted(func(value int, key string) {
+		keys = append(keys, key)
+	})
+	if !UseSlice(keys).Same([]string{"a", "b", "c"}) {
+		t.Fail()
+	}
+}
+
+func TestMap_SortByKey(t *testing.T) {
+	d := map[string]int{"b": 1, "c": 3, "a": 2, "d": 1}
+
+	byKey := UseMap(d).SortByKey()
+	if !UseSlice(byKey).Same([]Entry[string, int]{{Key: "a", Value: 2}, {Key: "b", Value: 1}, {Key: "c", Value: 3}, {Key: "d", Value: 1}}) {
+		t.Fail()
+	}
+
+	byValue := UseMap(d).SortByValue()
+	if !UseSlice(byValue).Same([]Entry[string, int]{{Key: "b", Value: 1}, {Key: "d", Value: 1}, {Key: "a", Value: 2}, {Key: "c", Value: 3}}) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"fmt"
+	. "github.com/sxyazi/go-collection"
+	"reflect"
+	"testing"
+)
+
+func mergeConfigs() (map[string]any, map[string]any) {
+	defaults := map[string]any{
+		"db":    map[string]any{"host": "localhost", "port": 5432, "options": map[string]any{"ssl": false}},
+		"hosts": []string{"a", "b"},
+		"debug": false,
+	}
+	overrides := map[string]any{
+		"db":    map[string]any{"host": "db.local", "options": map[string]any{"timeout": 30}},
+		"hosts": []string{"b", "c"},
+		"debug": true,
+	}
+	return defaults, overrides
+}
+
+func TestMap_DeepMerge(t *testing.T) {
+	defaults, overrides := mergeConfigs()
+
+	merged := DeepMerge(defaults, overrides)
+	expected := map[string]any{
+		"db":    map[string]any{"host": "db.local", "port": 5432, "options": map[string]any{"ssl": false, "timeout": 30}},
+		"hosts": []string{"b", "c"},
+		"debug": true,
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Error(merged)
+	}
+
+	// The inputs stay untouched
+	if original, _ := mergeConfigs(); !reflect.DeepEqual(defaults, original) {
+		t.Fail()
+	}
+
+	merged["db"].(map[string]any)["options"].(map[string]any)["ssl"] = true
+	if overrides["db"].(map[string]any)["options"].(map[string]any)["ssl"] != nil {
+		t.Fail()
+	}
+
+	untouched := DeepMerge(defaults, map[string]any{"debug": true})
+	untouched["db"].(map[string]any)["host"] = "changed"
+	untouched["hosts"].([]string)[0] = "changed"
+	if original, _ := mergeConfigs(); !reflect.DeepEqual(defaults, original) {
+		t.Fail()
+	}
+
+	layered := UseMap(map[string]any{"a": map[string]any{"x": 1}}).DeepMerge(
+		map[string]any{"a": map[string]any{"y": 2}},
+		map[string]any{"a": map[string]any{"z": 3}},
+	).All()
+	if !reflect.DeepEqual(layered, map[string]any{"a": map[string]any{"x": 1, "y": 2, "z": 3}}) {
+		t.Fail()
+	}
+}
+
+func TestMap_DeepMergeWithSlices(t *testing.T) {
+	defaults, overrides := mergeConfigs()
+
+	merged := DeepMergeWith(MergeOptions{Slices: SliceAppend}, defaults, overrides)
+	if !reflect.DeepEqual(merged["hosts"], []string{"a", "b", "b", "c"}) {
+		t.Fail()
+	}
+
+	merged = DeepMergeWith(MergeOptions{Slices: SliceAppendUnique}, defaults, overrides)
+	if !reflect.DeepEqual(merged["hosts"], []string{"a", "b", "c"}) {
+		t.Fail()
+	}
+
+	from := map[string][]any{"servers": {map[string]any{"host": "a", "port": 80}, "x"}}
+	to := map[string][]any{"servers": {map[string]any{"port": 8080}, nil, "y"}}
+	indexed := DeepMergeWith(MergeOptions{Slices: SliceMergeIndex}, from, to)
+	if !reflect.DeepEqual(indexed["servers"], []any{map[string]any{"host": "a", "port": 8080}, nil, "y"}) {
+		t.Error(indexed)
+	}
+	if from["servers"][0].(map[string]any)["port"] != 80 {
+		t.Fail()
+	}
+}
+
+func TestMap_DeepMergeWithConflict(t *testing.T) {
+	from := map[string]any{"port": "80", "db": map[string]any{"port": 5432}}
+	to := map[string]any{"port": 80, "db": map[string]any{"port": "5433"}}
+
+	if merged := DeepMerge(from, to); merged["port"] != 80 || merged["db"].(map[string]any)["port"] != "5433" {
+		t.Fail()
+	}
+
+	var paths []string
+	merged := DeepMergeWith(MergeOptions{Conflict: func(path string, from, to any) any {
+		paths = append(paths, path)
+		return fmt.Sprint(from, "|", to)
+	}}, from, to)
+	if merged["port"] != "80|80" || merged["db"].(map[string]any)["port"] != "5432|5433" || len(paths) != 2 {
+		t.Error(merged, paths)
+	}
+}
+
+func TestMap_DeepMergeWithMutate(t *testing.T) {
+	items := map[string]map[string]int{"a": {"x": 1}}
+	merged := DeepMergeWith(MergeOptions{Mutate: true}, items, map[string]map[string]int{"a": {"y": 2}, "b": {"z": 3}})
+
+	if !reflect.DeepEqual(items, map[string]map[string]int{"a": {"x": 1, "y": 2}, "b": {"z": 3}}) || len(merged) != 2 {
+		t.Error(items)
+	}
+
+	if DeepMergeWith[map[string]int](MergeOptions{Mutate: true}, nil, map[string]int{"a": 1})["a"] != 1 {
+		t.Fail()
+	}
+}
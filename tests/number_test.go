@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"errors"
 	. "github.com/sxyazi/go-collection"
 	"math"
+	"math/big"
 	"testing"
 )
 
@@ -86,3 +88,304 @@ func TestNumber_Median(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestNumber_Histogram(t *testing.T) {
+	d := []float64{1, 2, 2, 3, 3, 3, 4, 4, 4, 4}
+
+	counts, edges := UseNumber(d).Histogram(3)
+	if !UseSlice(counts).Same([]int{1, 2, 7}) || !UseSlice(edges).Same([]float64{1, 2, 3, 4}) {
+		t.Fail()
+	}
+
+	counts, edges = UseNumber(d).Histogram([]int{0, 2, 4})
+	if !UseSlice(counts).Same([]int{1, 9}) || !UseSlice(edges).Same([]float64{0, 2, 4}) {
+		t.Fail()
+	}
+
+	counts, _ = UseNumber([]int{-1, 5, 10, 11}).Histogram([]float64{0, 5, 10})
+	if !UseSlice(counts).Same([]int{0, 2}) {
+		t.Fail()
+	}
+
+	counts, edges = UseNumber([]int{7, 7}).Histogram(1)
+	if !UseSlice(counts).Same([]int{2}) || !UseSlice(edges).Same([]float64{6.5, 7.5}) {
+		t.Fail()
+	}
+
+	counts, _ = UseNumber([]float64{1, math.NaN(), 2}).Histogram(2)
+	if !UseSlice(counts).Same([]int{1, 1}) {
+		t.Fail()
+	}
+
+	counts, edges = UseNumber(d).Histogram(int64(3))
+	if !UseSlice(counts).Same([]int{1, 2, 7}) || !UseSlice(edges).Same([]float64{1, 2, 3, 4}) {
+		t.Fail()
+	}
+	if counts, _ = Histogram(d, uint8(3)); !UseSlice(counts).Same([]int{1, 2, 7}) {
+		t.Fail()
+	}
+
+	counts, edges = Histogram([]float64{1, 2, 3, math.Inf(1), math.Inf(-1)}, 2)
+	if !UseSlice(counts).Same([]int{1, 2}) || !UseSlice(edges).Same([]float64{1, 2, 3}) {
+		t.Fail()
+	}
+	if !UseSlice(Cut([]float64{1, math.Inf(1), 3}, 2)).Same([]int{0, -1, 1}) {
+		t.Fail()
+	}
+
+	defer func() {
+		if recover() != "number of bins must be positive" {
+			t.Fail()
+		}
+	}()
+	UseNumber(d).Histogram(uint(0))
+}
+
+func TestNumber_Cut(t *testing.T) {
+	d := []int{12, 55, 230, 90, 1000, -3}
+	if !UseSlice(UseNumber(d).Cut([]int{0, 50, 100, 500})).Same([]int{0, 1, 2, 1, -1, -1}) {
+		t.Fail()
+	}
+
+	if !UseSlice(UseNumber([]float64{0, 5, 10}).Cut(int32(2))).Same([]int{0, 1, 1}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_QCut(t *testing.T) {
+	d := []int{8, 1, 6, 3, 2, 7, 5, 4}
+	if !UseSlice(UseNumber(d).QCut(4)).Same([]int{3, 0, 2, 1, 0, 3, 2, 1}) {
+		t.Fail()
+	}
+
+	if !UseSlice(UseNumber([]float64{math.NaN(), 1, 2}).QCut(2)).Same([]int{-1, 0, 1}) {
+		t.Fail()
+	}
+
+	if !UseSlice(UseNumber([]int{}).QCut(2)).Same([]int{}) {
+		t.Fail()
+	}
+
+	// Repeated quantile edges are merged, so fewer bins than requested remain
+	if !UseSlice(UseNumber([]int{1, 1, 1, 1, 2}).QCut(4)).Same([]int{0, 0, 0, 0, 0}) {
+		t.Fail()
+	}
+	if !UseSlice(UseNumber([]int{1, 1, 1, 2, 3, 4}).QCut(4)).Same([]int{0, 0, 0, 1, 2, 2}) {
+		t.Fail()
+	}
+	if !UseSlice(UseNumber([]int{5, 5}).QCut(3)).Same([]int{0, 0}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_BinBy(t *testing.T) {
+	d := []User{{ID: 3, Name: "Lucy"}, {ID: 18, Name: "Peter"}, {ID: 12, Name: "Alex"}, {ID: 40, Name: "Mary"}}
+	binned := UseSlice(d).BinBy([]int{0, 10, 20}, func(value User, _ int) float64 {
+		return float64(value.ID)
+	})
+
+	if len(binned) != 2 {
+		t.FailNow()
+	}
+	if !UseSlice(binned[0]).Same([]User{{ID: 3, Name: "Lucy"}}) {
+		t.Fail()
+	}
+	if !UseSlice(binned[1]).Same([]User{{ID: 18, Name: "Peter"}, {ID: 12, Name: "Alex"}}) {
+		t.Fail()
+	}
+
+	// Functional test
+	if len(BinBy([]int{1, 2, 3}, 3, func(value, _ int) float64 { return float64(value) })) != 3 {
+		t.Fail()
+	}
+}
+
+func TestNumber_CumSum(t *testing.T) {
+	d := []int{1, 2, 3, 4}
+	if !UseNumber(d).CumSum().Same([]int{1, 3, 6, 10}) {
+		t.Fail()
+	}
+	if !UseSlice(d).Same([]int{1, 2, 3, 4}) {
+		t.Fail()
+	}
+	if !UseNumber([]float64{}).CumSum().Empty() {
+		t.Fail()
+	}
+}
+
+func TestNumber_CumProd(t *testing.T) {
+	if !UseNumber([]int{1, 2, 3, 4}).CumProd().Same([]int{1, 2, 6, 24}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_CumMax(t *testing.T) {
+	if !UseNumber([]float64{1, 3, 2, 5, 4}).CumMax().Same([]float64{1, 3, 3, 5, 5}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_CumMin(t *testing.T) {
+	if !UseNumber([]float64{5, 3, 4, 1, 2}).CumMin().Same([]float64{5, 3, 3, 1, 1}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_Delta(t *testing.T) {
+	d := []int{1, 4, 9, 16, 25}
+	if !UseNumber(d).Delta(1).Same([]float64{3, 5, 7, 9}) {
+		t.Fail()
+	}
+	if !UseNumber(d).Delta(2).Same([]float64{8, 12, 16}) {
+		t.Fail()
+	}
+	if !UseNumber(d).Delta(5).Empty() {
+		t.Fail()
+	}
+
+	if !UseSlice(Delta([]uint{5, 3, 10}, 1)).Same([]float64{-2, 7}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_PctChange(t *testing.T) {
+	if !UseNumber([]int{100, 110, 99}).PctChange().Same([]float64{0.1, -0.1}) {
+		t.Fail()
+	}
+
+	c := UseNumber([]float64{0, 1}).PctChange()
+	if v, _ := c.First(); !math.IsInf(v, 1) {
+		t.Fail()
+	}
+}
+
+func TestNumber_Normalize(t *testing.T) {
+	if !UseNumber([]int{10, 20, 15, 30}).Normalize().Same([]float64{0, 0.5, 0.25, 1}) {
+		t.Fail()
+	}
+	if !UseNumber([]int{3, 3}).Normalize().Same([]float64{0, 0}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_Standardize(t *testing.T) {
+	if !UseNumber([]int{2, 4, 4, 4, 5, 5, 7, 9}).Standardize().Same([]float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}) {
+		t.Fail()
+	}
+	if !UseNumber([]int{3, 3}).Standardize().Same([]float64{0, 0}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_Clamp(t *testing.T) {
+	d := []int{-5, 0, 5, 10, 15}
+	if !UseNumber(d).Clamp(0, 10).Same([]int{0, 0, 5, 10, 10}) {
+		t.Fail()
+	}
+	if !UseSlice(d).Same([]int{-5, 0, 5, 10, 15}) {
+		t.Fail()
+	}
+}
+
+func TestNumber_SumChecked(t *testing.T) {
+	if v, err := UseNumber([]int8{100, 20, 7}).SumChecked(); err != nil || v != 127 {
+		t.Fail()
+	}
+	if _, err := UseNumber([]int8{100, 20, 8}).SumChecked(); !errors.Is(err, ErrOverflow) {
+		t.Fail()
+	}
+	if _, err := UseNumber([]int8{-100, -20, -9}).SumChecked(); !errors.Is(err, ErrOverflow) {
+		t.Fail()
+	}
+	if _, err := UseNumber([]uint8{200, 56}).SumChecked(); !errors.Is(err, ErrOverflow) {
+		t.Fail()
+	}
+	if _, err := UseNumber([]float32{math.MaxFloat32, math.MaxFloat32}).SumChecked(); !errors.Is(err, ErrOverflow) {
+		t.Fail()
+	}
+	if v, err := UseNumber([]float64{math.Inf(1), 1}).SumChecked(); err != nil || !math.IsInf(v, 1) {
+		t.Fail()
+	}
+}
+
+func TestNumber_Precision(t *testing.T) {
+	d := make([]float64, 0, 10001)
+	d = append(d, 1e16)
+	for i := 0; i < 10000; i++ {
+		d = append(d, 1)
+	}
+	if UseNumber(d).Sum() != 1e16+10000 {
+		t.Fail()
+	}
+	if UseNumber([]float64{0.1, 0.2, 0.3}).Sum() != 0.6 {
+		t.Fail()
+	}
+
+	if UseNumber([]int8{100, 100, 100}).Avg() != 100 {
+		t.Fail()
+	}
+	if UseNumber([]int8{100, 120}).Median() != 110 {
+		t.Fail()
+	}
+}
+
+func TestBigNumber_Aggregations(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+	d1 := []*big.Int{huge, big.NewInt(-5), big.NewInt(20)}
+
+	c1 := UseBigInt(d1)
+	if c1.Sum().String() != "100000000000000000015" {
+		t.Fail()
+	}
+	if c1.Min().Int64() != -5 || c1.Max() != huge {
+		t.Fail()
+	}
+	if c1.Median().Cmp(big.NewRat(20, 1)) != 0 {
+		t.Fail()
+	}
+	if c1.Sort().All()[0].Int64() != -5 || c1.All()[2] != huge {
+		t.Fail()
+	}
+
+	d2 := []*big.Rat{big.NewRat(1, 3), big.NewRat(1, 6), big.NewRat(1, 2)}
+	if UseBigRat(d2).Sum().Cmp(big.NewRat(1, 1)) != 0 {
+		t.Fail()
+	}
+	if UseBigRat(d2).Avg().Cmp(big.NewRat(1, 3)) != 0 {
+		t.Fail()
+	}
+
+	if UseBigInt([]*big.Int{}).Sum().Sign() != 0 || UseBigInt([]*big.Int{}).Max().Sign() != 0 {
+		t.Fail()
+	}
+}
+
+func TestBigNumber_Decimal(t *testing.T) {
+	var d []Decimal
+	for _, s := range []string{"19.99", "5.01", "0.333", "100"} {
+		v, _ := ParseDecimal(s)
+		d = append(d, v)
+	}
+
+	c := UseDecimal(d)
+	if c.Sum().String() != "125.333" {
+		t.Fail()
+	}
+	if c.Min().String() != "0.333" || c.Max().String() != "100" {
+		t.Fail()
+	}
+	if DecimalFromRat(c.Avg(), 2, RoundHalfEven).String() != "31.33" {
+		t.Fail()
+	}
+	if DecimalFromRat(c.Median(), 2, RoundHalfEven).String() != "12.50" {
+		t.Fail()
+	}
+
+	limit, _ := ParseDecimal("10")
+	sorted := Map(UseDecimal(d).Where(">=", limit).SortDesc().All(), func(value Decimal, _ int) Decimal {
+		return value.Round(2, RoundHalfUp)
+	})
+	if len(sorted) != 2 || sorted[0].String() != "100.00" || sorted[1].String() != "19.99" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"math"
+	"testing"
+)
+
+func TestPagination_ForPage(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7}
+	if !UseSlice(d).ForPage(2, 3).Same([]int{4, 5, 6}) {
+		t.Fail()
+	}
+	if !UseSlice(d).ForPage(3, 3).Same([]int{7}) {
+		t.Fail()
+	}
+	if !UseSlice(d).ForPage(0, 3).Same([]int{1, 2, 3}) {
+		t.Fail()
+	}
+	if !UseSlice(d).ForPage(4, 3).Empty() {
+		t.Fail()
+	}
+	if !UseSlice(d).ForPage(math.MaxInt, 3).Empty() {
+		t.Fail()
+	}
+}
+
+func TestPagination_Paginate(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7}
+
+	p1 := UseSlice(d).Paginate(2, 3)
+	if !UseSlice(p1.Items).Same([]int{4, 5, 6}) || p1.Total != 7 || p1.PerPage != 3 || p1.CurrentPage != 2 {
+		t.Fail()
+	}
+	if p1.LastPage != 3 || p1.From != 4 || p1.To != 6 || !p1.HasNext {
+		t.Fail()
+	}
+
+	p2 := Paginate(d, 3, 3)
+	if !UseSlice(p2.Items).Same([]int{7}) || p2.From != 7 || p2.To != 7 || p2.HasNext {
+		t.Fail()
+	}
+
+	p3 := Paginate(d, 5, 3)
+	if len(p3.Items) != 0 || p3.From != 0 || p3.To != 0 || p3.HasNext {
+		t.Fail()
+	}
+
+	p4 := Paginate([]int{}, 1, 10)
+	if p4.LastPage != 1 || p4.Total != 0 || p4.HasNext {
+		t.Fail()
+	}
+
+	p5 := Paginate(d, math.MaxInt, 2)
+	if len(p5.Items) != 0 || p5.From != 0 || p5.To != 0 || p5.HasNext {
+		t.Fail()
+	}
+}
+
+func TestPagination_CursorPaginate(t *testing.T) {
+	d := []User{{ID: 5, Name: "e"}, {ID: 1, Name: "a"}, {ID: 4, Name: "d"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}
+
+	p1, err := UseSlice(d).CursorPaginate("ID", "", 2)
+	if err != nil || !UseSlice(p1.Items).Same([]User{d[1], d[3]}) || !p1.HasNext || p1.NextCursor == "" {
+		t.FailNow()
+	}
+
+	p2, err := UseSlice(d).CursorPaginate("ID", p1.NextCursor, 2)
+	if err != nil || !UseSlice(p2.Items).Same([]User{d[4], d[2]}) || !p2.HasNext {
+		t.FailNow()
+	}
+
+	p3, err := UseSlice(d).CursorPaginate("ID", p2.NextCursor, 2)
+	if err != nil || !UseSlice(p3.Items).Same([]User{d[0]}) || p3.HasNext || p3.NextCursor != "" {
+		t.Fail()
+	}
+
+	cursor, _ := EncodeCursor("b")
+	p4, err := CursorPaginate(d, "Name", cursor, 10)
+	if err != nil || !UseSlice(p4.Items).Same([]User{d[4], d[2], d[0]}) {
+		t.Fail()
+	}
+
+	if _, err := CursorPaginate(d, "ID", "%%%", 2); !errors.Is(err, ErrInvalidCursor) {
+		t.Fail()
+	}
+	if _, err := CursorPaginate(d, "ID", cursor, 2); !errors.Is(err, ErrInvalidCursor) {
+		t.Fail()
+	}
+	if _, err := CursorPaginate([]Foo{{}, {}}, "Bar", "", 1); err != nil {
+		t.Fail()
+	}
+	if _, err := CursorPaginate([][]int{{1}, {2}}, 0, "", 1); err != nil {
+		t.Fail()
+	}
+	if _, err := CursorPaginate([]map[string]any{{"k": []int{1}}, {"k": []int{2}}}, "k", "", 1); err == nil {
+		t.Fail()
+	}
+	if _, err := CursorPaginate(d, "ID", "", 0); err == nil {
+		t.Fail()
+	}
+	if p, err := CursorPaginate(d, "ID", "", math.MaxInt); err != nil || len(p.Items) != len(d) || p.HasNext {
+		t.Fail()
+	}
+}
+
+func TestPagination_CursorPaginateDuplicates(t *testing.T) {
+	d := []map[string]int{{"ID": 1, "Score": 20}, {"ID": 2, "Score": 10}, {"ID": 3, "Score": 20}, {"ID": 4, "Score": 30}}
+
+	p1, err := CursorPaginate(d, "Score", "", 2)
+	if err != nil || !UseSlice(p1.Items).Same([]map[string]int{d[1], d[0]}) || !p1.HasNext {
+		t.FailNow()
+	}
+
+	p2, err := CursorPaginate(d, "Score", p1.NextCursor, 2)
+	if err != nil || !UseSlice(p2.Items).Same([]map[string]int{d[2], d[3]}) || p2.HasNext {
+		t.Fail()
+	}
+
+	cursor, _ := EncodeCursor(20)
+	p3, err := CursorPaginate(d, "Score", cursor, 2)
+	if err != nil || !UseSlice(p3.Items).Same([]map[string]int{d[3]}) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"sync/atomic"
+	"testing"
+)
+
+func parallelItems(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+func TestSlice_ParallelMap(t *testing.T) {
+	items := parallelItems(10000)
+	mapped, err := ParallelMap(items, func(value int, index int) int {
+		return value * 2
+	}, ParallelOptions{Workers: 8, ChunkSize: 7})
+	if err != nil || len(mapped) != len(items) {
+		t.Fail()
+	}
+	for i, v := range mapped {
+		if v != i*2 {
+			t.Fatal(i, v)
+		}
+	}
+
+	if mapped, err := ParallelMap([]int{}, func(value int, index int) int { return value }); err != nil || len(mapped) != 0 {
+		t.Fail()
+	}
+}
+
+func TestSlice_ParallelFilter(t *testing.T) {
+	filtered, err := UseSlice(parallelItems(1000)).ParallelFilter(func(value int, index int) bool {
+		return value%3 == 0
+	}).Result()
+	if err != nil || len(filtered) != 334 {
+		t.Fail()
+	}
+	for i, v := range filtered {
+		if v != i*3 {
+			t.Fatal(i, v)
+		}
+	}
+}
+
+func TestSlice_ParallelEach(t *testing.T) {
+	var sum atomic.Int64
+	err := ParallelEach(parallelItems(1000), func(value int, index int) {
+		sum.Add(int64(value))
+	}, ParallelOptions{Workers: 3})
+	if err != nil || sum.Load() != 499500 {
+		t.Fail()
+	}
+}
+
+func TestSlice_ParallelReduce(t *testing.T) {
+	items := parallelItems(1001)
+	add := func(a, b int) int { return a + b }
+	if total, err := ParallelReduce(items, 10, add, ParallelOptions{ChunkSize: 10}); err != nil || total != 500510 {
+		t.Fail()
+	}
+
+	// Associative but not commutative
+	words := []string{"a", "b", "c", "d", "e", "f", "g"}
+	joined, err := UseSlice(words).ParallelReduce(">", func(a, b string) string {
+		return a + b
+	}, ParallelOptions{Workers: 4, ChunkSize: 2})
+	if err != nil || joined != ">abcdefg" {
+		t.Fail()
+	}
+}
+
+func TestSlice_ParallelPanic(t *testing.T) {
+	_, err := ParallelMap(parallelItems(100), func(value int, index int) int {
+		if value == 42 {
+			panic("boom")
+		}
+		return value
+	}, ParallelOptions{Workers: 4, ChunkSize: 5})
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Index != 42 || panicErr.Value != "boom" {
+		t.Fail()
+	}
+	if err.Error() != "panic at index 42: boom" {
+		t.Fail()
+	}
+}
+
+func TestSlice_ParallelContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var visited atomic.Int64
+	err := ParallelEach(parallelItems(10000), func(value int, index int) {
+		if visited.Add(1) == 10 {
+			cancel()
+		}
+	}, ParallelOptions{Context: ctx, Workers: 2, ChunkSize: 10})
+	if !errors.Is(err, context.Canceled) || visited.Load() >= 10000 {
+		t.Fail()
+	}
+
+	c := UseSlice(parallelItems(10)).ParallelMap(func(value int, index int) int {
+		return value
+	}, ParallelOptions{Context: ctx})
+	if !errors.Is(c.Err(), context.Canceled) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"math/rand"
+	"testing"
+)
+
+func TestSlice_Edits(t *testing.T) {
+	from, to := []string{"a", "b", "c", "a", "b", "b", "a"}, []string{"c", "b", "a", "b", "a", "c"}
+
+	patch := Edits(from, to)
+	if result, err := ApplyPatch(from, patch); err != nil || !Same(result, to) {
+		t.Fail()
+	}
+
+	var changes int
+	for _, edit := range patch {
+		if edit.Op != EditEqual {
+			changes += len(edit.Items)
+		}
+	}
+	if changes != 5 {
+		t.Fail()
+	}
+
+	edits := Edits([]int{1, 2, 3}, []int{1, 4, 3})
+	if len(edits) != 4 {
+		t.Fail()
+	}
+	if edits[0].Op != EditEqual || edits[0].OldIndex != 0 || edits[0].NewIndex != 0 || !Same(edits[0].Items, []int{1}) {
+		t.Fail()
+	}
+	if edits[1].Op != EditDelete || edits[1].OldIndex != 1 || !Same(edits[1].Items, []int{2}) {
+		t.Fail()
+	}
+	if edits[2].Op != EditInsert || edits[2].OldIndex != 2 || edits[2].NewIndex != 1 || !Same(edits[2].Items, []int{4}) {
+		t.Fail()
+	}
+	if edits[3].Op != EditEqual || edits[3].OldIndex != 2 || edits[3].NewIndex != 2 {
+		t.Fail()
+	}
+
+	if len(Edits([]int{}, []int{})) != 0 {
+		t.Fail()
+	}
+	if patch := Edits([]int{}, []int{1, 2}); len(patch) != 1 || patch[0].Op != EditInsert {
+		t.Fail()
+	}
+	if patch := UseSlice([]int{1, 2}).Edits([]int{}); len(patch) != 1 || patch[0].Op != EditDelete {
+		t.Fail()
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := make([]int, r.Intn(20)), make([]int, r.Intn(20))
+		for j := range a {
+			a[j] = r.Intn(4)
+		}
+		for j := range b {
+			b[j] = r.Intn(4)
+		}
+		if result, err := ApplyPatch(a, Edits(a, b)); err != nil || !Same(result, b) {
+			t.Fail()
+		}
+	}
+}
+
+func TestSlice_EditsFunc(t *testing.T) {
+	patch := EditsFunc([]string{"A", "b"}, []string{"a", "B"}, func(a, b string) bool {
+		return a == b || a[0]^b[0] == 32
+	})
+	if len(patch) != 1 || patch[0].Op != EditEqual {
+		t.Fail()
+	}
+}
+
+func TestSlice_ApplyPatch(t *testing.T) {
+	patch := Edits([]int{1, 2, 3}, []int{1, 4, 3})
+
+	if _, err := ApplyPatch([]int{1, 5, 3}, patch); !errors.Is(err, ErrPatchMismatch) {
+		t.Fail()
+	}
+	if _, err := ApplyPatch([]int{1, 2}, patch); !errors.Is(err, ErrPatchMismatch) {
+		t.Fail()
+	}
+	if _, err := ApplyPatch([]int{1, 2, 3, 4}, patch); !errors.Is(err, ErrPatchMismatch) {
+		t.Fail()
+	}
+}
+
+func TestSlice_Apply(t *testing.T) {
+	patch := UseSlice([]int{1, 2, 3}).Edits([]int{1, 4, 3})
+
+	if z, err := UseSlice([]int{1, 2, 3}).Apply(patch).Result(); err != nil || !Same(z, []int{1, 4, 3}) {
+		t.Fail()
+	}
+	if z, err := UseSlice([]int{1, 5, 3}).Apply(patch).Result(); !errors.Is(err, ErrPatchMismatch) || !Same(z, []int{1, 5, 3}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_DiffBy(t *testing.T) {
+	from := []User{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}, {ID: 3, Name: "baz"}}
+	to := []User{{ID: 3, Name: "qux"}, {ID: 1, Name: "foo"}, {ID: 4, Name: "quux"}}
+
+	diff := DiffBy[uint](from, to, "ID")
+	if len(diff.Added) != 1 || diff.Added[0].ID != 4 {
+		t.Fail()
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].ID != 2 {
+		t.Fail()
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Key != 3 || diff.Changed[0].To.Name != "qux" {
+		t.Fail()
+	}
+	if fields := diff.Changed[0].Fields; len(fields) != 1 || fields[0].Field != "Name" || fields[0].From != "baz" || fields[0].To != "qux" {
+		t.Fail()
+	}
+
+	maps := DiffBy[int]([]map[string]int{{"id": 1, "a": 1, "b": 2}}, []map[string]int{{"id": 1, "a": 1, "c": 3}}, "id")
+	if fields := maps.Changed[0].Fields; len(fields) != 2 || fields[0].Field != "b" || fields[0].To != nil || fields[1].Field != "c" || fields[1].To != 3 {
+		t.Fail()
+	}
+
+	byFunc := DiffBy[int]([]int{1, 2}, []int{2, 3}, func(item int) int { return item })
+	if len(byFunc.Added) != 1 || byFunc.Added[0] != 3 || len(byFunc.Removed) != 1 || byFunc.Removed[0] != 1 || len(byFunc.Changed) != 0 {
+		t.Fail()
+	}
+}
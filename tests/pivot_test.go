@@ -0,0 +1,98 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"strings"
+	"testing"
+)
+
+var pivotSales = []Sale{
+	{Region: "US", Month: 2, Amount: 10},
+	{Region: "EU", Month: 1, Amount: 20},
+	{Region: "US", Month: 1, Amount: 5},
+	{Region: "US", Month: 2, Amount: 15},
+	{Region: "APAC", Month: 3, Amount: 8},
+}
+
+func TestPivot_Pivot(t *testing.T) {
+	p := Pivot[string, int](pivotSales, "Region", "Month", AggSum("Amount"))
+	if !UseSlice(p.Rows).Same([]string{"US", "EU", "APAC"}) || !UseSlice(p.Columns).Same([]int{2, 1, 3}) {
+		t.Fail()
+	}
+
+	if v, ok := p.Get("US", 2); !ok || v != 25.0 {
+		t.Fail()
+	}
+	if _, ok := p.Get("EU", 2); ok {
+		t.Fail()
+	}
+	if p.RowTotals["US"] != 30.0 || p.ColumnTotals[1] != 25.0 || p.Total != 58.0 {
+		t.Fail()
+	}
+
+	avg := Pivot[string, int](pivotSales, "Region", func(value Sale) int {
+		return value.Month
+	}, AggAvg("Amount"))
+	if v, _ := avg.Get("US", 2); v != 12.5 || avg.RowTotals["US"] != 10.0 {
+		t.Fail()
+	}
+
+	if p := Pivot[string, int](pivotSales, "Missing", "Month", AggCount()); len(p.Rows) != 0 || p.Total != 0 {
+		t.Fail()
+	}
+}
+
+func TestPivot_Sort(t *testing.T) {
+	p := Pivot[string, int](pivotSales, "Region", "Month", AggCount())
+	p.SortRows(func(a, b string) bool { return a < b }).SortColumns(func(a, b int) bool { return a < b })
+	if !UseSlice(p.Rows).Same([]string{"APAC", "EU", "US"}) || !UseSlice(p.Columns).Same([]int{1, 2, 3}) {
+		t.Fail()
+	}
+}
+
+func TestPivot_Maps(t *testing.T) {
+	p := Pivot[string, int](pivotSales, "Region", "Month", AggCount())
+	maps := p.Maps("Region")
+	if len(maps) != 4 {
+		t.FailNow()
+	}
+
+	if maps[0]["Region"] != "US" || maps[0]["1"] != 1 || maps[0]["2"] != 2 || maps[0]["3"] != nil || maps[0]["Total"] != 3 {
+		t.Fail()
+	}
+	if maps[3]["Region"] != "Total" || maps[3]["2"] != 2 || maps[3]["Total"] != 5 {
+		t.Fail()
+	}
+	collides := func(rowHeader string, sales []Sale) (r any) {
+		defer func() { r = recover() }()
+		Pivot[int, string](sales, "Month", "Region", AggCount()).Maps(rowHeader)
+		return
+	}
+	if collides("Month", pivotSales) != nil {
+		t.Fail()
+	}
+	if collides("Total", pivotSales) != "row header collides with the Total column" {
+		t.Fail()
+	}
+	if collides("US", pivotSales) != `column "US" collides with another key of the row` {
+		t.Fail()
+	}
+	if collides("Month", []Sale{{Region: "Total", Month: 1}}) != `column "Total" collides with another key of the row` {
+		t.Fail()
+	}
+}
+
+func TestPivot_WriteCSV(t *testing.T) {
+	p := Pivot[string, int](pivotSales, "Region", "Month", AggSum("Amount"))
+	p.SortColumns(func(a, b int) bool { return a < b })
+
+	var b strings.Builder
+	if err := p.WriteCSV(&b, "Region"); err != nil {
+		t.FailNow()
+	}
+
+	expected := "Region,1,2,3,Total\nUS,5,25,,30\nEU,20,,,20\nAPAC,,,8,8\nTotal,25,25,8,58\n"
+	if b.String() != expected {
+		t.Fail()
+	}
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	. "github.com/sxyazi/go-collection"
+	"sync"
+	"testing"
+)
+
+func TestRandom_Source(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	s1 := UseSlice(append([]int{}, d...)).Shuffle(NewRandomSource(42)).All()
+	s2 := UseSlice(append([]int{}, d...)).Shuffle(NewRandomSource(42)).All()
+	if !UseSlice(s1).Same(s2) {
+		t.Fail()
+	}
+
+	r1, _ := UseSlice(d).Random(NewRandomSource(7))
+	r2, _ := UseSlice(d).Random(NewRandomSource(7))
+	if r1 != r2 {
+		t.Fail()
+	}
+
+	for i := 0; i < 10; i++ {
+		if v, ok := Random(d, CryptoRandomSource); !ok || !UseSlice(d).Contains(v) {
+			t.Fail()
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Shuffle([]int{1, 2, 3})
+			Random(d)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRandom_Sample(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	c := UseSlice(d).Sample(4, NewRandomSource(1))
+	if c.Len() != 4 || UseSlice(c.All()).Unique().Len() != 4 {
+		t.Fail()
+	}
+	if UseSlice(Diff(c.All(), d)).Len() != 0 {
+		t.Fail()
+	}
+	if !UseSlice(d).Same([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		t.Fail()
+	}
+
+	if !UseSlice(Sample(d, 4, NewRandomSource(1))).Same(Sample(d, 4, NewRandomSource(1))) {
+		t.Fail()
+	}
+	if len(Sample(d, 20)) != 10 || len(Sample(d, -1)) != 0 {
+		t.Fail()
+	}
+}
+
+func TestRandom_SampleWithReplacement(t *testing.T) {
+	c := UseSlice([]int{1, 2}).SampleWithReplacement(50, NewRandomSource(1))
+	if c.Len() != 50 || !UseNumber(Unique(c.All())).Sort().Same([]int{1, 2}) {
+		t.Fail()
+	}
+
+	if len(SampleWithReplacement([]int{}, 3)) != 0 {
+		t.Fail()
+	}
+}
+
+func TestRandom_WeightedRandom(t *testing.T) {
+	weight := func(value string, _ int) float64 {
+		if value == "b" {
+			return 1
+		}
+		return 0
+	}
+
+	for i := 0; i < 20; i++ {
+		if v, ok := UseSlice([]string{"a", "b", "c"}).WeightedRandom(weight); !ok || v != "b" {
+			t.Fail()
+		}
+	}
+
+	if _, ok := WeightedRandom([]string{"a", "c"}, weight); ok {
+		t.Fail()
+	}
+
+	counts := map[int]int{}
+	src := NewRandomSource(3)
+	for i := 0; i < 1000; i++ {
+		v, _ := WeightedRandom([]int{1, 9}, func(value, _ int) float64 { return float64(value) }, src)
+		counts[v]++
+	}
+	if counts[9] < 800 || counts[1] < 50 {
+		t.Fail()
+	}
+}
+
+func TestRandom_WeightedSample(t *testing.T) {
+	weight := func(value, _ int) float64 { return float64(value) }
+
+	c := UseSlice([]int{0, 1, 2, 3}).WeightedSample(3, weight, NewRandomSource(1))
+	if c.Len() != 3 || !UseNumber(c.All()).Sort().Same([]int{1, 2, 3}) {
+		t.Fail()
+	}
+
+	if len(WeightedSample([]int{0, 1, 2}, 5, weight)) != 2 {
+		t.Fail()
+	}
+}
+
+func TestRandom_Reservoir(t *testing.T) {
+	r := NewReservoir[int](3, NewRandomSource(1))
+	for i := 1; i <= 2; i++ {
+		r.Add(i)
+	}
+	if !UseSlice(r.Items()).Same([]int{1, 2}) {
+		t.Fail()
+	}
+
+	for i := 3; i <= 1000; i++ {
+		r.Add(i)
+	}
+	if r.Seen() != 1000 || len(r.Items()) != 3 || UseSlice(r.Items()).Unique().Len() != 3 {
+		t.Fail()
+	}
+}
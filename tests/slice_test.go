@@ -3,6 +3,7 @@ package tests
 import (
 	. "github.com/sxyazi/go-collection"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -137,6 +138,15 @@ func TestSlice_Index(t *testing.T) {
 		t.Fail()
 	}
 
+	// Named type
+	type ID string
+	if v := UseSlice([]ID{"a", "b"}).Index("b"); v != 1 {
+		t.Fail()
+	}
+	if v := UseSlice([]ID{"a", "b"}).Index("c"); v != -1 {
+		t.Fail()
+	}
+
 	// Struct
 	f1 := Foo{}
 	f2 := Foo{Bar: "b"}
@@ -203,6 +213,69 @@ func TestSlice_Diff(t *testing.T) {
 	if !UseSlice(d1).Diff(d2).Same([]int{1, 3, 5}) {
 		t.Fail()
 	}
+	if UseSlice(d1).Diff(d1).All() != nil {
+		t.Fail()
+	}
+
+	// Float
+	d3 := []float64{0.1 + 0.2, 1, math.NaN()}
+	if !UseSlice(d3).Diff([]float64{0.3, math.NaN()}).Same([]float64{1}) {
+		t.Fail()
+	}
+	if !UseSlice([]float64{1, 2 + 1e-10, 3, 5}).Diff([]float64{4, 2, 1 - 1e-10, 9}).Same([]float64{3, 5}) {
+		t.Fail()
+	}
+	if f := UseSlice([]float32{1, float32(math.NaN())}).Diff([]float32{float32(math.NaN())}).All(); len(f) != 2 {
+		t.Fail()
+	}
+
+	// Named types
+	type ID string
+	type Level int
+	if !UseSlice([]ID{"a", "b", "c"}).Diff([]ID{"b"}).Same([]ID{"a", "c"}) {
+		t.Fail()
+	}
+	if !UseSlice([]Level{1, 2, 3}).Diff([]Level{3, 1}).Same([]Level{2}) {
+		t.Fail()
+	}
+
+	// Struct and nested slice
+	s1, s2 := []int{1}, []int{2}
+	if !UseSlice([][]int{s1, s2}).Diff([][]int{s2}).Same([][]int{s1}) {
+		t.Fail()
+	}
+	if !UseSlice([]Foo{{Bar: "a"}, {Bar: "b"}}).Diff([]Foo{{Bar: "b"}}).Same([]Foo{{Bar: "a"}}) {
+		t.Fail()
+	}
+
+	// Mixed
+	d4 := []any{1, int8(2), uint(3), "4", nil}
+	if !UseSlice(d4).Diff([]any{int64(1), 2, 3, "4", nil}).Same([]any{uint(3)}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_Intersect(t *testing.T) {
+	d1 := []int{1, 2, 3, 4, 5}
+	d2 := []int{2, 4, 6, 8}
+	if !UseSlice(d1).Intersect(d2).Same([]int{2, 4}) {
+		t.Fail()
+	}
+
+	d3 := []float64{0.1 + 0.2, 1}
+	if !UseSlice(d3).Intersect([]float64{0.3}).Same([]float64{0.1 + 0.2}) {
+		t.Fail()
+	}
+
+	type ID string
+	if !UseSlice([]ID{"a", "b"}).Intersect([]ID{"b", "c"}).Same([]ID{"b"}) {
+		t.Fail()
+	}
+
+	type Ratio float64
+	if !UseSlice([]Ratio{0.5, 1}).Intersect([]Ratio{1}).Same([]Ratio{1}) {
+		t.Fail()
+	}
 }
 
 func TestSlice_Filter(t *testing.T) {
@@ -736,3 +809,65 @@ func TestSlice_WhereNotIn(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestSlice_BinarySearch(t *testing.T) {
+	d := []int{1, 3, 5, 7}
+	if i, ok := BinarySearch(d, 5); !ok || i != 2 {
+		t.Fail()
+	}
+	if i, ok := BinarySearch(d, 4); ok || i != 2 {
+		t.Fail()
+	}
+	if i, ok := BinarySearch([]string{"a", "c"}, "b"); ok || i != 1 {
+		t.Fail()
+	}
+
+	users := []User{{ID: 1}, {ID: 4}, {ID: 9}}
+	if i, ok := UseSlice(users).BinarySearchFunc(User{ID: 4}, func(a, b User) int {
+		return int(a.ID) - int(b.ID)
+	}); !ok || i != 1 {
+		t.Fail()
+	}
+}
+
+func TestSlice_Compact(t *testing.T) {
+	if !UseSlice([]int{1, 1, 2, 1, 3, 3}).Compact().Same([]int{1, 2, 1, 3}) {
+		t.Fail()
+	}
+	if !UseSlice([]float64{0.1 + 0.2, 0.3, 1}).Compact().Same([]float64{0.1 + 0.2, 1}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_InsertDeleteReplace(t *testing.T) {
+	c := UseSlice([]int{1, 2, 3}).Insert(1, 7, 8)
+	if !c.Same([]int{1, 7, 8, 2, 3}) {
+		t.Fail()
+	}
+	if !c.Delete(0, 2).Same([]int{8, 2, 3}) {
+		t.Fail()
+	}
+	if !c.Replace(1, 2, 4, 5, 6).Same([]int{8, 4, 5, 6, 3}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_ClipGrow(t *testing.T) {
+	d := make([]int, 2, 10)
+	if c := UseSlice(d).Clip(); cap(c.All()) != 2 {
+		t.Fail()
+	}
+	if c := UseSlice([]int{1}).Grow(10); cap(c.All()) < 11 || c.Len() != 1 {
+		t.Fail()
+	}
+}
+
+func TestSlice_EqualFunc(t *testing.T) {
+	eq := func(a, b string) bool { return strings.EqualFold(a, b) }
+	if !UseSlice([]string{"A", "b"}).EqualFunc([]string{"a", "B"}, eq) {
+		t.Fail()
+	}
+	if UseSlice([]string{"A"}).EqualFunc([]string{"a", "b"}, eq) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"io"
+	"testing"
+)
+
+func counter(limit int) *Stream[int] {
+	n := 0
+	return NewStream(func() (int, error) {
+		if n >= limit {
+			return 0, io.EOF
+		}
+		n++
+		return n, nil
+	})
+}
+
+func TestStream(t *testing.T) {
+	var pulled []int
+	s := counter(10).Map(func(value int, index int) int {
+		pulled = append(pulled, value)
+		return value * 10
+	}).Filter(func(value int, index int) bool {
+		return value%20 == 0
+	}).Take(2)
+
+	if len(pulled) != 0 {
+		t.Fail()
+	}
+
+	result, err := s.Collect().Result()
+	if err != nil || !Same(result, []int{20, 40}) || !Same(pulled, []int{1, 2, 3, 4}) {
+		t.Fail()
+	}
+
+	if v, ok := s.Next(); ok || v != 0 {
+		t.Fail()
+	}
+}
+
+func TestStream_Where(t *testing.T) {
+	if count, err := counter(10).Where(">", 7).Count(); err != nil || count != 3 {
+		t.Fail()
+	}
+
+	users := UseSlice([]User{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}}).Stream().Where("Name", "bar").Collect().All()
+	if len(users) != 1 || users[0].ID != 2 {
+		t.Fail()
+	}
+}
+
+func TestStream_Err(t *testing.T) {
+	errBroken := errors.New("broken")
+	n := 0
+	s := NewStream(func() (int, error) {
+		if n++; n > 2 {
+			return 0, errBroken
+		}
+		return n, nil
+	})
+
+	c := s.Collect()
+	if !Same(c.All(), []int{1, 2}) || !errors.Is(c.Err(), errBroken) || s.Err() != errBroken {
+		t.Fail()
+	}
+}
+
+func TestStream_WithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var visited []int
+	err := counter(100).WithContext(ctx).Each(func(value int, index int) {
+		visited = append(visited, value)
+		if value == 3 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) || !Same(visited, []int{1, 2, 3}) {
+		t.Fail()
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	s := counter(100).WithContext(ctx).Filter(func(value int, index int) bool {
+		if value == 5 {
+			cancel()
+		}
+		return false
+	})
+	if count, err := s.Count(); count != 0 || !errors.Is(err, context.Canceled) {
+		t.Fail()
+	}
+}
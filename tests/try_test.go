@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"errors"
+	. "github.com/sxyazi/go-collection"
+	"strconv"
+	"testing"
+)
+
+var errOdd = errors.New("odd number")
+
+func TestSlice_TryMap(t *testing.T) {
+	double := func(value int, _ int) (int, error) {
+		if value%2 != 0 {
+			return 0, errOdd
+		}
+		return value * 2, nil
+	}
+
+	if mapped, err := TryMap([]int{2, 4}, double); err != nil || !Same(mapped, []int{4, 8}) {
+		t.Fail()
+	}
+
+	mapped, err := TryMap([]int{2, 3, 4, 5}, double)
+	if mapped != nil || !errors.Is(err, errOdd) || err.Error() != "index 1: odd number" {
+		t.Fail()
+	}
+
+	mapped, err = TryMap([]int{2, 3, 4, 5}, double, CollectErrors)
+	if !Same(mapped, []int{4, 8}) || !errors.Is(err, errOdd) || err.Error() != "index 1: odd number\nindex 3: odd number" {
+		t.Fail()
+	}
+
+	if _, err := TryMap([]string{"1", "x"}, func(value string, _ int) (string, error) {
+		_, err := strconv.Atoi(value)
+		return value, err
+	}); !errors.Is(err, strconv.ErrSyntax) {
+		t.Fail()
+	}
+}
+
+func TestSlice_TryFilter(t *testing.T) {
+	even := func(value int, _ int) (bool, error) {
+		if value < 0 {
+			return false, errors.New("negative")
+		}
+		return value%2 == 0, nil
+	}
+
+	if filtered, err := TryFilter([]int{1, 2, 3, 4}, even); err != nil || !Same(filtered, []int{2, 4}) {
+		t.Fail()
+	}
+	if filtered, err := TryFilter([]int{1, -2, 4}, even); filtered != nil || err == nil {
+		t.Fail()
+	}
+	if filtered, err := TryFilter([]int{1, -2, 4}, even, CollectErrors); !Same(filtered, []int{4}) || err.Error() != "index 1: negative" {
+		t.Fail()
+	}
+}
+
+func TestSlice_TryEach(t *testing.T) {
+	var visited []int
+	callback := func(value int, _ int) error {
+		visited = append(visited, value)
+		if value%2 != 0 {
+			return errOdd
+		}
+		return nil
+	}
+
+	if err := TryEach([]int{2, 3, 4, 5}, callback); !errors.Is(err, errOdd) || !Same(visited, []int{2, 3}) {
+		t.Fail()
+	}
+
+	visited = nil
+	if err := TryEach([]int{2, 3, 4, 5}, callback, CollectErrors); !errors.Is(err, errOdd) || !Same(visited, []int{2, 3, 4, 5}) {
+		t.Fail()
+	}
+}
+
+func TestSlice_TryReduce(t *testing.T) {
+	sum := func(carry int, value int, _ int) (int, error) {
+		if value%2 != 0 {
+			return 0, errOdd
+		}
+		return carry + value, nil
+	}
+
+	if total, err := TryReduce([]int{2, 4}, 1, sum); err != nil || total != 7 {
+		t.Fail()
+	}
+	if total, err := TryReduce([]int{2, 3, 4}, 1, sum); err == nil || total != 0 {
+		t.Fail()
+	}
+	if total, err := TryReduce([]int{2, 3, 4}, 1, sum, CollectErrors); err == nil || total != 7 {
+		t.Fail()
+	}
+}
+
+func TestSlice_Try(t *testing.T) {
+	toInt := func(value string, _ int) (string, error) {
+		n, err := strconv.Atoi(value)
+		return strconv.Itoa(n * 10), err
+	}
+	notEmpty := func(value string, _ int) (bool, error) {
+		return value != "0", nil
+	}
+
+	result, err := UseSlice([]string{"1", "0", "2"}).TryMap(toInt).TryFilter(notEmpty).Result()
+	if err != nil || !Same(result, []string{"10", "20"}) {
+		t.Fail()
+	}
+
+	c := UseSlice([]string{"1", "x", "2", "y"}).TryMap(toInt)
+	if result, err := c.Result(); result != nil || err == nil || c.Err() != err {
+		t.Fail()
+	}
+
+	c = UseSlice([]string{"1", "x", "2", "y"}).TryMap(toInt, CollectErrors).TryEach(func(value string, index int) error {
+		if value == "20" {
+			return errOdd
+		}
+		return nil
+	}, CollectErrors)
+	if result, err := c.Result(); !Same(result, []string{"10", "20"}) || !errors.Is(err, strconv.ErrSyntax) || !errors.Is(err, errOdd) {
+		t.Fail()
+	}
+
+	if _, err := c.TryReduce("", func(carry, value string, _ int) (string, error) {
+		return carry + value, nil
+	}); err == nil {
+		t.Fail()
+	}
+	if UseSlice([]int{1}).Err() != nil {
+		t.Fail()
+	}
+}
@@ -8,3 +8,15 @@ type User struct {
 	ID   uint
 	Name string
 }
+
+type Order struct {
+	ID     uint
+	UserID uint
+	Amount float64
+}
+
+type Sale struct {
+	Region string
+	Month  int
+	Amount float64
+}
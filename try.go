@@ -0,0 +1,99 @@
+package collect
+
+import (
+	"errors"
+	"fmt"
+)
+
+type ErrorPolicy int
+
+const (
+	// StopOnError stops at the first failing element and discards the result
+	StopOnError ErrorPolicy = iota
+	// CollectErrors skips the failing elements and joins all their errors
+	CollectErrors
+)
+
+func errorPolicy(policy []ErrorPolicy) ErrorPolicy {
+	if len(policy) > 0 {
+		return policy[0]
+	}
+	return StopOnError
+}
+
+func TryMap[T ~[]E, E any](items T, callback func(value E, index int) (E, error), policy ...ErrorPolicy) (T, error) {
+	var errs []error
+	mapped := make(T, 0, len(items))
+	for index, item := range items {
+		value, err := callback(item, index)
+		if err == nil {
+			mapped = append(mapped, value)
+			continue
+		}
+
+		err = fmt.Errorf("index %d: %w", index, err)
+		if errorPolicy(policy) == StopOnError {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+
+	return mapped, errors.Join(errs...)
+}
+
+func TryFilter[T ~[]E, E any](items T, callback func(value E, index int) (bool, error), policy ...ErrorPolicy) (T, error) {
+	var errs []error
+	var filtered T
+	for index, item := range items {
+		ok, err := callback(item, index)
+		if err == nil {
+			if ok {
+				filtered = append(filtered, item)
+			}
+			continue
+		}
+
+		err = fmt.Errorf("index %d: %w", index, err)
+		if errorPolicy(policy) == StopOnError {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+
+	return filtered, errors.Join(errs...)
+}
+
+func TryEach[T ~[]E, E any](items T, callback func(value E, index int) error, policy ...ErrorPolicy) error {
+	var errs []error
+	for index, item := range items {
+		if err := callback(item, index); err != nil {
+			err = fmt.Errorf("index %d: %w", index, err)
+			if errorPolicy(policy) == StopOnError {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func TryReduce[T ~[]E, E any](items T, initial E, callback func(carry E, value E, key int) (E, error), policy ...ErrorPolicy) (E, error) {
+	var errs []error
+	for key, item := range items {
+		carry, err := callback(initial, item, key)
+		if err == nil {
+			initial = carry
+			continue
+		}
+
+		err = fmt.Errorf("index %d: %w", key, err)
+		if errorPolicy(policy) == StopOnError {
+			var zero E
+			return zero, err
+		}
+		errs = append(errs, err)
+	}
+
+	return initial, errors.Join(errs...)
+}